@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -19,7 +20,8 @@ func newCurrentCommand() *currentCommand {
 	currentCmd := &cobra.Command{
 		Use:   "current",
 		Short: "Show the current version (latest tag)",
-		Long: `Show the current version by displaying the latest semver tag.
+		Long: `Show the current version by displaying the latest version tag, parsed with
+the version scheme from the config file.
 
 This command is useful for scripting and CI/CD pipelines where you need
 to quickly check the current version without launching the interactive UI.`,
@@ -27,6 +29,12 @@ to quickly check the current version without launching the interactive UI.`,
 	}
 
 	currentCmd.Flags().StringP("prefix", "p", "v", "Tag prefix to filter versions")
+	currentCmd.Flags().String("at", "", "Show the latest tag reachable from the given revision")
+	currentCmd.Flags().String(
+		"remote-url",
+		"",
+		"Show the latest tag of the repository at this URL, listed without cloning",
+	)
 
 	c.cmd = currentCmd
 	return c
@@ -34,13 +42,47 @@ to quickly check the current version without launching the interactive UI.`,
 
 func (c *currentCommand) execute(cmd *cobra.Command, _ []string) error {
 	prefix, _ := cmd.Flags().GetString("prefix")
+	at, _ := cmd.Flags().GetString("at")
+	remoteURL, _ := cmd.Flags().GetString("remote-url")
+	if remoteURL != "" && at != "" {
+		return fmt.Errorf("--at cannot be used with --remote-url")
+	}
 
-	repo, err := git.OpenFromCurrent()
+	cfg, err := loadConfig(cmd)
 	if err != nil {
-		return fmt.Errorf("not a git repository")
+		return err
 	}
 
-	tag, err := repo.LatestTag(prefix)
+	var repo *git.Repository
+	if remoteURL != "" {
+		repo, err = git.OpenRemote(remoteURL)
+		if err != nil {
+			return err
+		}
+	} else {
+		repo, err = openRepo(cmd)
+		if err != nil {
+			return NewExitError(ExitNotGitRepo, "not a git repository", err)
+		}
+	}
+	if err := setScheme(repo, cfg); err != nil {
+		return fmt.Errorf("invalid version scheme: %w", err)
+	}
+	if err := setTagType(repo, cfg); err != nil {
+		return fmt.Errorf("invalid tag config: %w", err)
+	}
+
+	var tag *git.Tag
+	if remoteURL != "" {
+		// Remote tags are always listed with go-git
+		repo.SetNativeTransport(nativeTransport(cfg))
+		tag, err = repo.LatestRemoteTag(cmd.Context(), "origin", prefix)
+		if errors.Is(err, git.ErrRemoteUnreachable) {
+			return NewExitError(ExitRemoteUnreachable, "failed to list remote tags", err)
+		}
+	} else {
+		tag, _, err = latestTagAt(repo, prefix, at)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get latest tag: %w", err)
 	}
@@ -50,6 +92,9 @@ func (c *currentCommand) execute(cmd *cobra.Command, _ []string) error {
 		return nil
 	}
 
+	if w := repo.TagTypeWarning(tag); w != "" {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Warning:", w)
+	}
 	fmt.Fprintln(cmd.OutOrStdout(), tag.Name)
 	return nil
 }
@@ -76,6 +76,8 @@ func TestCurrentCommand_NotGitRepo(t *testing.T) {
 	err = cmd.Execute()
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "not a git repository")
+	assert.Contains(t, err.Error(), "or any of the parent directories", "the cause is kept")
+	assert.Equal(t, ExitNotGitRepo, GetExitCode(err))
 }
 
 func TestCurrentCommand_NoTags(t *testing.T) {
@@ -126,3 +128,170 @@ func TestCurrentCommand_NoTags(t *testing.T) {
 	output := buf.String()
 	assert.Contains(t, output, "No version tags found")
 }
+
+func TestCurrentCommand_At(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+
+	require.NoError(t, os.Chdir(tmpDir))
+
+	ctx := context.Background()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "v1.0.0"},
+		{"commit", "--allow-empty", "-m", "feat: next"},
+		{"tag", "v1.1.0"},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run())
+	}
+
+	buf := new(bytes.Buffer)
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"current", "--at", "HEAD~1"})
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "v1.0.0\n", buf.String())
+}
+
+func TestCurrentCommand_CalVer(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+
+	require.NoError(t, os.Chdir(tmpDir))
+
+	ctx := context.Background()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "v2026.09.1"},
+		{"tag", "v99.0.0"},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run())
+	}
+	config := "scheme: calver\ncalver:\n  format: YYYY.0M.MICRO\n"
+	require.NoError(t, os.WriteFile(".bumpkin.yaml", []byte(config), 0o600))
+
+	buf := new(bytes.Buffer)
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"current"})
+
+	require.NoError(t, cmd.Execute())
+	// v99.0.0 does not match the calver layout
+	assert.Equal(t, "v2026.09.1\n", buf.String())
+
+	// Calendar versions have no minor bump
+	cmd = NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"--minor", "--dry-run"})
+
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Equal(t, ExitInvalidArgs, GetExitCode(err))
+}
+
+func TestCurrentCommand_TagTypePolicy(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+
+	require.NoError(t, os.Chdir(tmpDir))
+
+	ctx := context.Background()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-a", "v1.0.0", "-m", "Release v1.0.0"},
+		{"tag", "v1.0.1"},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run())
+	}
+
+	config := "tag:\n  type-policy: warn\n"
+	require.NoError(t, os.WriteFile(".bumpkin.yaml", []byte(config), 0o600))
+
+	buf, errBuf := new(bytes.Buffer), new(bytes.Buffer)
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(buf)
+	cmd.SetErr(errBuf)
+	cmd.SetArgs([]string{"current"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "v1.0.1\n", buf.String())
+	assert.Contains(t, errBuf.String(), "Warning: tag v1.0.1 is lightweight, expected annotated")
+
+	config = "tag:\n  type-policy: ignore\n"
+	require.NoError(t, os.WriteFile(".bumpkin.yaml", []byte(config), 0o600))
+
+	buf = new(bytes.Buffer)
+	cmd = NewRootCmd(testBuildInfo())
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"current"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "v1.0.0\n", buf.String())
+}
+
+func TestCurrentCommand_RemoteURL(t *testing.T) {
+	remoteDir := t.TempDir()
+	ctx := context.Background()
+	require.NoError(t, exec.CommandContext(ctx, "git", "init", "--bare", remoteDir).Run())
+
+	srcDir := t.TempDir()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-a", "v1.0.0", "-m", "Release v1.0.0"},
+		{"commit", "--allow-empty", "-m", "feat: new feature"},
+		{"tag", "-a", "v1.1.0", "-m", "Release v1.1.0"},
+		{"push", remoteDir, "--tags"},
+	} {
+		cmd := exec.CommandContext(ctx, "git", args...)
+		cmd.Dir = srcDir
+		require.NoError(t, cmd.Run(), args)
+	}
+
+	// No clone or local repository is needed
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+	require.NoError(t, os.Chdir(t.TempDir()))
+
+	buf := new(bytes.Buffer)
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"current", "--remote-url", remoteDir})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "v1.1.0\n", buf.String())
+
+	cmd = NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"current", "--remote-url", remoteDir + "-missing"})
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Equal(t, ExitRemoteUnreachable, GetExitCode(err))
+}
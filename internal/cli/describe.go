@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/benny123tw/bumpkin/internal/executor"
+)
+
+// describeJSONOutput is the JSON output of the describe command
+type describeJSONOutput struct {
+	Git     string `json:"git"`
+	Semver  string `json:"semver"`
+	Go      string `json:"go"`
+	Tag     string `json:"tag,omitempty"`
+	Commits int    `json:"commits"`
+	Commit  string `json:"commit"`
+	Dirty   bool   `json:"dirty"`
+}
+
+type describeCommand struct {
+	cmd *cobra.Command
+}
+
+// newDescribeCommand creates a command that prints a build version for HEAD,
+// relative to the latest tag, in git describe, semver or Go pseudo-version form.
+func newDescribeCommand() *describeCommand {
+	c := &describeCommand{}
+
+	describeCmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Print a build version relative to the latest tag",
+		Long: `Print a build version for HEAD relative to the latest tag.
+
+Formats:
+  git     v1.4.2-5-gabc1234, with -dirty for uncommitted changes (default)
+  semver  1.4.3-dev.5+abc1234, a valid semver that sorts after the tag
+  go      v1.4.3-0.20240102150405-abc1234def56, a Go pseudo-version`,
+		Example: `  go build -ldflags "-X main.version=$(bumpkin describe --format semver)"`,
+		Args:    cobra.NoArgs,
+		RunE:    c.execute,
+	}
+
+	describeCmd.Flags().StringP("prefix", "p", "v", "Tag prefix")
+	describeCmd.Flags().StringP("format", "f", "git", "Output format: git, semver or go")
+	describeCmd.Flags().Bool("json", false, "Output all formats as JSON")
+
+	c.cmd = describeCmd
+	return c
+}
+
+func (c *describeCommand) execute(cmd *cobra.Command, _ []string) error {
+	format, _ := cmd.Flags().GetString("format")
+	asJSON, _ := cmd.Flags().GetBool("json")
+
+	if format != "git" && format != "semver" && format != "go" {
+		return NewExitError(
+			ExitInvalidArgs,
+			fmt.Sprintf("unknown format %q (use git, semver or go)", format),
+			nil,
+		)
+	}
+
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+	prefix := configPrefix(cmd, cfg)
+
+	repo, err := openRepo(cmd)
+	if err != nil {
+		return NewExitError(ExitNotGitRepo, "not a git repository", err)
+	}
+
+	desc, err := executor.Describe(cmd.Context(), repo, prefix)
+	if err != nil {
+		return fmt.Errorf("failed to describe HEAD: %w", err)
+	}
+
+	if asJSON {
+		return encodeJSON(cmd, describeJSONOutput{
+			Git:     desc.String(),
+			Semver:  desc.Semver(),
+			Go:      desc.GoPseudo(),
+			Tag:     desc.Tag,
+			Commits: desc.Commits,
+			Commit:  desc.Hash,
+			Dirty:   desc.Dirty,
+		})
+	}
+
+	out := desc.String()
+	switch format {
+	case "semver":
+		out = desc.Semver()
+	case "go":
+		out = desc.GoPseudo()
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), out)
+	return nil
+}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+
+	require.NoError(t, os.Chdir(tmpDir))
+
+	ctx := context.Background()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "v1.4.2"},
+		{"commit", "--allow-empty", "-m", "fix: one"},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run())
+	}
+
+	tests := []struct {
+		format  string
+		pattern string
+	}{
+		{"git", `^v1\.4\.2-1-g[0-9a-f]{7}\n$`},
+		{"semver", `^1\.4\.3-dev\.1\+[0-9a-f]{7}\n$`},
+		{"go", `^v1\.4\.3-0\.\d{14}-[0-9a-f]{12}\n$`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			cmd := NewRootCmd(testBuildInfo())
+			cmd.SetOut(buf)
+			cmd.SetArgs([]string{"describe", "--format", tt.format})
+
+			require.NoError(t, cmd.Execute())
+			assert.Regexp(t, tt.pattern, buf.String())
+		})
+	}
+}
+
+func TestDescribeCommand_ConfigPrefix(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+
+	require.NoError(t, os.Chdir(tmpDir))
+
+	ctx := context.Background()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "v1.4.2"},
+		{"tag", "v9.0.0"},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run())
+	}
+
+	// Only tags under the config prefix are described
+	require.NoError(t, os.WriteFile(".bumpkin.yaml", []byte("prefix: v1.\n"), 0o600))
+
+	for _, command := range []string{"describe", "ldflags"} {
+		buf := new(bytes.Buffer)
+		cmd := NewRootCmd(testBuildInfo())
+		cmd.SetOut(buf)
+		cmd.SetArgs([]string{command})
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, buf.String(), "1.4.2", command)
+		assert.NotContains(t, buf.String(), "9.0.0", command)
+	}
+}
+
+func TestDescribeCommand_UnknownFormat(t *testing.T) {
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"describe", "--format", "xml"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Equal(t, ExitInvalidArgs, GetExitCode(err))
+}
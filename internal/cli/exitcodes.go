@@ -9,6 +9,11 @@ const (
 	ExitNoCommits     = 4 // No commits since last tag
 	ExitUserCancelled = 5 // User cancelled operation
 	ExitHookFailed    = 6 // Hook execution failed
+	ExitUnverified    = 7 // Tag signatures are missing or untrusted
+
+	ExitTagExists         = 8  // The release tag already exists on the remote
+	ExitPushRejected      = 9  // The remote rejected the release push
+	ExitRemoteUnreachable = 10 // The remote could not be reached
 )
 
 // ExitError is an error that carries an exit code
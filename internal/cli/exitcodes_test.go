@@ -21,6 +21,10 @@ func TestExitCodes(t *testing.T) {
 		{"no commits", ExitNoCommits, 4},
 		{"user cancelled", ExitUserCancelled, 5},
 		{"hook failed", ExitHookFailed, 6},
+		{"unverified", ExitUnverified, 7},
+		{"tag exists", ExitTagExists, 8},
+		{"push rejected", ExitPushRejected, 9},
+		{"remote unreachable", ExitRemoteUnreachable, 10},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/benny123tw/bumpkin/internal/config"
+	"github.com/benny123tw/bumpkin/internal/executor"
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+// addFetchFlags registers the pre-flight tag fetch flags on cmd
+func addFetchFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(
+		"no-fetch",
+		false,
+		"Don't fetch the remote's tags before computing the version (overrides fetch.disabled)",
+	)
+}
+
+// fetchTags fetches the remote's tags before the version is computed, unless
+// --no-fetch or fetch.disabled turns it off
+func fetchTags(cmd *cobra.Command, repo *git.Repository, cfg *config.Config) error {
+	noFetch, _ := cmd.Flags().GetBool("no-fetch")
+	if noFetch || cfg.Fetch.Disabled {
+		return nil
+	}
+	maxDepth := cfg.Fetch.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = executor.DefaultMaxFetchDepth
+	}
+	remote := primaryRemote(cmd, pushRemotes(cmd, cfg))
+	prefix, _ := cmd.Flags().GetString("prefix")
+	return executor.FetchTags(cmd.Context(), repo, remote, prefix, maxDepth)
+}
+
+// handleFetchError reports a failed tag fetch, as ExitRemoteUnreachable if
+// the remote could not be reached
+func handleFetchError(cmd *cobra.Command, err error) error {
+	err = fmt.Errorf("%w (use --no-fetch to skip)", err)
+	if errors.Is(err, git.ErrRemoteUnreachable) {
+		return handleErrorWithCode(cmd, ExitRemoteUnreachable, "", err)
+	}
+	return handleError(cmd, err, "")
+}
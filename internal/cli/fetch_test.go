@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRootCommand_FetchTags(t *testing.T) {
+	remoteDir := t.TempDir()
+	ctx := context.Background()
+	require.NoError(t, exec.CommandContext(ctx, "git", "init", "--bare", remoteDir).Run())
+
+	tmpDir := t.TempDir()
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+	require.NoError(t, os.Chdir(tmpDir))
+
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-a", "v1.0.0", "-m", "Release v1.0.0"},
+		{"remote", "add", "origin", remoteDir},
+		{"push", "origin", "HEAD", "v1.0.0"},
+		// v1.1.0 was released from another checkout
+		{"push", "origin", "v1.0.0^{commit}:refs/tags/v1.1.0"},
+		{"commit", "--allow-empty", "-m", "fix: bug"},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run(), args)
+	}
+
+	run := func(args ...string) JSONOutput {
+		t.Helper()
+		buf := new(bytes.Buffer)
+		cmd := NewRootCmd(testBuildInfo())
+		cmd.SetOut(buf)
+		cmd.SetArgs(append([]string{"--patch", "--dry-run", "--json"}, args...))
+		require.NoError(t, cmd.Execute())
+		var out JSONOutput
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+		return out
+	}
+
+	assert.Equal(t, "1.0.1", run("--no-fetch").NewVersion)
+	assert.Equal(t, "1.1.1", run().NewVersion)
+}
+
+func TestRootCommand_FetchTags_MovedFloatingTag(t *testing.T) {
+	remoteDir := t.TempDir()
+	ctx := context.Background()
+	require.NoError(t, exec.CommandContext(ctx, "git", "init", "--bare", remoteDir).Run())
+
+	git := func(dir string, args ...string) {
+		t.Helper()
+		cmd := exec.CommandContext(ctx, "git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git %v: %s", args, out)
+	}
+
+	localDir := t.TempDir()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-a", "v1.0.0", "-m", "Release v1.0.0"},
+		{"tag", "v1"},
+		{"remote", "add", "origin", remoteDir},
+		{"push", "origin", "HEAD", "v1.0.0", "v1"},
+	} {
+		git(localDir, args...)
+	}
+
+	// Another clone releases v1.1.0 and moves the floating v1 tag
+	otherDir := t.TempDir()
+	for _, args := range [][]string{
+		{"clone", remoteDir, "."},
+		{"config", "user.email", "other@test.com"},
+		{"config", "user.name", "Other"},
+		{"commit", "--allow-empty", "-m", "feat: more"},
+		{"tag", "-a", "v1.1.0", "-m", "Release v1.1.0"},
+		{"tag", "-f", "v1"},
+		{"push", "-f", "origin", "HEAD", "v1.1.0", "v1"},
+	} {
+		git(otherDir, args...)
+	}
+
+	buf := new(bytes.Buffer)
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"--repo", localDir, "--patch", "--dry-run", "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var out JSONOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, "1.1.1", out.NewVersion)
+}
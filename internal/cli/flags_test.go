@@ -137,6 +137,39 @@ func TestFlags_Prefix(t *testing.T) {
 	assert.Equal(t, "release-", prefix)
 }
 
+func TestFlags_Pre(t *testing.T) {
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetArgs([]string{"--pre", "canary", "--force"})
+
+	err := cmd.ParseFlags([]string{"--pre", "canary", "--force"})
+	require.NoError(t, err)
+
+	pre, err := cmd.Flags().GetString("pre")
+	require.NoError(t, err)
+	assert.Equal(t, "canary", pre)
+
+	force, err := cmd.Flags().GetBool("force")
+	require.NoError(t, err)
+	assert.True(t, force)
+}
+
+func TestFlags_Promote(t *testing.T) {
+	cmd := NewRootCmd(testBuildInfo())
+	args := []string{"--promote", "--from", "v1.2.0-rc.1"}
+	cmd.SetArgs(args)
+
+	err := cmd.ParseFlags(args)
+	require.NoError(t, err)
+
+	promote, err := cmd.Flags().GetBool("promote")
+	require.NoError(t, err)
+	assert.True(t, promote)
+
+	from, err := cmd.Flags().GetString("from")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.0-rc.1", from)
+}
+
 // Test mutual exclusivity of bump flags
 func TestFlags_MutualExclusivity(t *testing.T) {
 	tests := []struct {
@@ -210,3 +243,29 @@ func TestFlags_Defaults(t *testing.T) {
 	json, _ := cmd.Flags().GetBool("json")
 	assert.False(t, json)
 }
+
+func TestFlags_Build(t *testing.T) {
+	cmd := NewRootCmd(testBuildInfo())
+	args := []string{"--patch", "--build", "ci.42"}
+	cmd.SetArgs(args)
+
+	err := cmd.ParseFlags(args)
+	require.NoError(t, err)
+
+	build, err := cmd.Flags().GetString("build")
+	require.NoError(t, err)
+	assert.Equal(t, "ci.42", build)
+}
+
+func TestFlags_ForceReason(t *testing.T) {
+	cmd := NewRootCmd(testBuildInfo())
+	args := []string{"--set-version", "1.0.0", "--force", "--reason", "backport"}
+	cmd.SetArgs(args)
+
+	err := cmd.ParseFlags(args)
+	require.NoError(t, err)
+
+	reason, err := cmd.Flags().GetString("reason")
+	require.NoError(t, err)
+	assert.Equal(t, "backport", reason)
+}
@@ -0,0 +1,235 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/benny123tw/bumpkin/internal/executor"
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/tui"
+)
+
+// hotfixJSONOutput extends JSONOutput with the hotfix branch details
+type hotfixJSONOutput struct {
+	JSONOutput
+	Branch        string   `json:"branch"`
+	BaseTag       string   `json:"base_tag"`
+	BranchCreated bool     `json:"branch_created"`
+	BranchPushed  bool     `json:"branch_pushed"`
+	CherryPicked  []string `json:"cherry_picked,omitempty"`
+}
+
+type hotfixCommand struct {
+	cmd   *cobra.Command
+	picks []string
+}
+
+// newHotfixCommand creates a command that releases a patch on the version line
+// of an older tag, from a hotfix/MAJOR.MINOR.x branch.
+func newHotfixCommand() *hotfixCommand {
+	c := &hotfixCommand{}
+
+	hotfixCmd := &cobra.Command{
+		Use:   "hotfix <tag>",
+		Short: "Release a patch for an older version",
+		Long: `Release a patch for an older version from a maintenance branch.
+
+The hotfix/MAJOR.MINOR.x branch is created from the given tag (or checked out
+if it already exists), and the next patch version is computed from the tags on
+that branch rather than the latest tag overall.
+
+Without --yes, a picker lets you choose commits from the current branch to
+cherry-pick onto the hotfix branch before tagging.`,
+		Example: `  bumpkin hotfix v1.8.2
+  bumpkin hotfix v1.8.2 --pick abc1234 --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: c.execute,
+	}
+
+	hotfixCmd.Flags().StringSliceVar(
+		&c.picks,
+		"pick",
+		nil,
+		"Commit to cherry-pick onto the hotfix branch (repeatable)",
+	)
+	hotfixCmd.Flags().StringP("prefix", "p", "v", "Tag prefix")
+	hotfixCmd.Flags().StringP("remote", "r", "origin", "Git remote name")
+	hotfixCmd.Flags().StringP("config", "C", ".bumpkin.yaml", "Config file path")
+	hotfixCmd.Flags().BoolP("dry-run", "d", false, "Preview without making changes")
+	hotfixCmd.Flags().Bool("no-push", false, "Create tag but don't push")
+	hotfixCmd.Flags().Bool("no-hooks", false, "Skip hook execution")
+	hotfixCmd.Flags().Bool("floating", false, "Move floating tags like v1.8 to the hotfix")
+	addSignFlags(hotfixCmd)
+	addTagFlags(hotfixCmd)
+	addPushFlags(hotfixCmd)
+	addFetchFlags(hotfixCmd)
+	hotfixCmd.Flags().BoolP("yes", "y", false, "Skip the commit picker")
+	hotfixCmd.Flags().Bool("json", false, "Output result as JSON")
+
+	c.cmd = hotfixCmd
+	return c
+}
+
+func (c *hotfixCommand) execute(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+	applyConfigDefaults(cmd, cfg)
+
+	repo, err := openRepo(cmd)
+	if err != nil {
+		return handleErrorWithCode(cmd, ExitNotGitRepo, "not a git repository", err)
+	}
+	if err := setScheme(repo, cfg); err != nil {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "invalid version scheme", err)
+	}
+	if err := setTagType(repo, cfg); err != nil {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "invalid tag config", err)
+	}
+	if err := setSigner(cmd, repo, cfg); err != nil {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "invalid signing config", err)
+	}
+	setTagger(cmd, repo)
+	if err := setTransport(cmd, repo, cfg); err != nil {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "invalid push config", err)
+	}
+	if err := fetchTags(cmd, repo, cfg); err != nil {
+		return handleFetchError(cmd, err)
+	}
+
+	prefix, _ := cmd.Flags().GetString("prefix")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	noPush, _ := cmd.Flags().GetBool("no-push")
+	noHooks, _ := cmd.Flags().GetBool("no-hooks")
+	yes, _ := cmd.Flags().GetBool("yes")
+	asJSON, _ := cmd.Flags().GetBool("json")
+
+	base, err := executor.HotfixBase(repo, prefix, args[0])
+	if err != nil {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "invalid hotfix base", err)
+	}
+
+	picks := make([]string, 0, len(c.picks))
+	for _, rev := range c.picks {
+		hash, err := repo.ResolveRevision(rev)
+		if err != nil {
+			return handleErrorWithCode(cmd, ExitInvalidArgs, "", err)
+		}
+		picks = append(picks, hash.String())
+	}
+
+	verifier, err := tagVerifier(cfg)
+	if err != nil {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "invalid verify config", err)
+	}
+
+	remotes := pushRemotes(cmd, cfg)
+	req := executor.HotfixRequest{
+		Repository:    repo,
+		BaseTag:       base.Name,
+		CherryPick:    picks,
+		Prefix:        prefix,
+		Remote:        primaryRemote(cmd, remotes),
+		DryRun:        dryRun,
+		NoPush:        noPush,
+		NoHooks:       noHooks,
+		Floating:      floating(cmd, cfg),
+		Verifier:      verifier,
+		RequireSigned: cfg.Tag.Verify.Required,
+		Message:       tagMessage(cmd, cfg),
+		PreTagHooks:   cfg.Hooks.PreTag,
+		PostTagHooks:  cfg.Hooks.PostTag,
+		PostPushHooks: cfg.Hooks.PostPush,
+		HookTemplates: cfg.Hooks.Templates,
+		Remotes:       remotes,
+	}
+
+	if !yes && !dryRun {
+		req.CherryPick, err = pickHotfixCommits(cmd, repo, req, base)
+		if err != nil {
+			if errors.Is(err, tui.ErrCancelled) {
+				return handleErrorWithCode(cmd, ExitUserCancelled, "hotfix cancelled", nil)
+			}
+			return handleError(cmd, err, "failed to pick commits")
+		}
+	}
+
+	result, err := executor.Hotfix(cmd.Context(), req)
+	if errors.Is(err, executor.ErrUntrustedTags) {
+		return handleErrorWithCode(cmd, ExitUnverified, "", err)
+	}
+	if code := pushExitCode(err); code != ExitGeneralError {
+		return handleErrorWithCode(cmd, code, "", err)
+	}
+	if err != nil {
+		return handleError(cmd, err, "hotfix failed")
+	}
+
+	if asJSON {
+		return encodeJSON(cmd, hotfixJSONOutput{
+			JSONOutput:    newJSONOutput(cmd, result.Result, nil),
+			Branch:        result.Branch,
+			BaseTag:       result.BaseTag,
+			BranchCreated: result.BranchCreated,
+			BranchPushed:  result.BranchPushed,
+			CherryPicked:  result.CherryPicked,
+		})
+	}
+
+	out := cmd.OutOrStdout()
+	branchState := "existing"
+	if result.BranchCreated {
+		branchState = "new, from " + result.BaseTag
+	}
+	fmt.Fprintf(out, "Branch: %s (%s)\n", result.Branch, branchState)
+	if len(result.CherryPicked) > 0 {
+		fmt.Fprintf(out, "Cherry-picked: %d commit(s)\n", len(result.CherryPicked))
+	}
+	return outputText(cmd, result.Result)
+}
+
+// pickHotfixCommits shows the commits on the current branch since the base tag
+// and returns the --pick commits followed by the ones picked in the TUI
+func pickHotfixCommits(
+	cmd *cobra.Command,
+	repo *git.Repository,
+	req executor.HotfixRequest,
+	base *git.Tag,
+) ([]string, error) {
+	// Preview the version the hotfix would create
+	previewReq := req
+	previewReq.DryRun = true
+	preview, err := executor.Hotfix(cmd.Context(), previewReq)
+	if err != nil {
+		return nil, err
+	}
+
+	commits, err := repo.GetCommitsSinceTag(base.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	picked, err := tui.RunPicker(tui.PickerConfig{
+		Title: fmt.Sprintf("Hotfix %s → %s", base.Name, preview.TagName),
+		Summary: fmt.Sprintf(
+			"Pick commits to cherry-pick onto %s, then press enter to tag",
+			preview.Branch,
+		),
+		Commits: commits,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := req.CherryPick
+	for _, c := range picked {
+		if !slices.Contains(hashes, c.Hash) {
+			hashes = append(hashes, c.Hash)
+		}
+	}
+	return hashes, nil
+}
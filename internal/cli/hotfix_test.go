@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHotfixCommand_RequiresTag(t *testing.T) {
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{"hotfix"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "accepts 1 arg")
+}
+
+func TestHotfixCommand_FlagsDoNotShareRootState(t *testing.T) {
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{
+		"hotfix", "v1.8.2",
+		"--repo", t.TempDir(),
+		"--prefix", "release-",
+		"--remote", "upstream",
+		"--dry-run",
+	})
+
+	require.Error(t, cmd.Execute())
+	assert.Equal(t, "v", flagPrefix)
+	assert.Equal(t, "origin", flagRemote)
+	assert.False(t, flagDryRun)
+}
+
+func TestHotfixCommand_Yes(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+
+	require.NoError(t, os.Chdir(tmpDir))
+
+	ctx := context.Background()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-a", "v1.8.2", "-m", "Release v1.8.2"},
+		{"commit", "--allow-empty", "-m", "feat: next"},
+		{"tag", "-a", "v1.9.0", "-m", "Release v1.9.0"},
+		{"commit", "--allow-empty", "-m", "fix: critical bug"},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run())
+	}
+
+	buf := new(bytes.Buffer)
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"hotfix", "v1.8.2", "--pick", "HEAD", "--yes", "--json"})
+
+	require.NoError(t, cmd.Execute())
+
+	var out hotfixJSONOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.True(t, out.Success)
+	assert.Equal(t, "hotfix/1.8.x", out.Branch)
+	assert.Equal(t, "v1.8.3", out.TagName)
+	assert.True(t, out.BranchCreated)
+	assert.Len(t, out.CherryPicked, 1)
+}
@@ -16,6 +16,10 @@ prefix: v
 # Git remote (default: "origin")
 remote: origin
 
+# Prerelease channels, least stable first (default: alpha, beta, rc)
+# prerelease:
+#   channels: [alpha, beta, rc]
+
 # Hooks - commands to run at different stages
 hooks:
   # Commands to run before creating the tag
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/benny123tw/bumpkin/internal/executor"
+)
+
+type ldflagsCommand struct {
+	cmd *cobra.Command
+}
+
+// newLdflagsCommand creates a command that prints -X linker flags filling in
+// version, commit and date variables from the repository state.
+func newLdflagsCommand() *ldflagsCommand {
+	c := &ldflagsCommand{}
+
+	ldflagsCmd := &cobra.Command{
+		Use:   "ldflags",
+		Short: "Print Go linker flags that set version variables",
+		Long: `Print -X linker flags that set version, commit and date variables.
+
+The version is the one 'bumpkin describe' computes: the tag's version on a
+tagged commit, otherwise a semver build version. The date is the commit time,
+so builds of the same commit are reproducible.`,
+		Example: `  go build -ldflags "$(bumpkin ldflags --var main.version --commit-var main.commit)"`,
+		Args:    cobra.NoArgs,
+		RunE:    c.execute,
+	}
+
+	ldflagsCmd.Flags().StringP("prefix", "p", "v", "Tag prefix")
+	ldflagsCmd.Flags().String("var", "main.version", "Variable to set to the version")
+	ldflagsCmd.Flags().String("commit-var", "", "Variable to set to the short commit hash")
+	ldflagsCmd.Flags().String("date-var", "", "Variable to set to the commit date (RFC 3339)")
+	ldflagsCmd.Flags().StringP("format", "f", "semver", "Version format: git, semver or go")
+
+	c.cmd = ldflagsCmd
+	return c
+}
+
+func (c *ldflagsCommand) execute(cmd *cobra.Command, _ []string) error {
+	versionVar, _ := cmd.Flags().GetString("var")
+	commitVar, _ := cmd.Flags().GetString("commit-var")
+	dateVar, _ := cmd.Flags().GetString("date-var")
+	format, _ := cmd.Flags().GetString("format")
+
+	if format != "git" && format != "semver" && format != "go" {
+		return NewExitError(
+			ExitInvalidArgs,
+			fmt.Sprintf("unknown format %q (use git, semver or go)", format),
+			nil,
+		)
+	}
+
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+	prefix := configPrefix(cmd, cfg)
+
+	repo, err := openRepo(cmd)
+	if err != nil {
+		return NewExitError(ExitNotGitRepo, "not a git repository", err)
+	}
+
+	desc, err := executor.Describe(cmd.Context(), repo, prefix)
+	if err != nil {
+		return fmt.Errorf("failed to describe HEAD: %w", err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), ldflags(desc, format, versionVar, commitVar, dateVar))
+	return nil
+}
+
+// ldflags builds the -X flags for the given variables, skipping empty names
+func ldflags(desc *executor.Description, format, versionVar, commitVar, dateVar string) string {
+	v := desc.Semver()
+	switch format {
+	case "git":
+		v = desc.String()
+	case "go":
+		v = desc.GoPseudo()
+	}
+
+	var flags []string
+	if versionVar != "" {
+		flags = append(flags, fmt.Sprintf("-X %s=%s", versionVar, v))
+	}
+	if commitVar != "" {
+		flags = append(flags, fmt.Sprintf("-X %s=%s", commitVar, desc.Hash[:7]))
+	}
+	if dateVar != "" {
+		flags = append(flags, fmt.Sprintf("-X %s=%s", dateVar, desc.CommitTime.UTC().Format(time.RFC3339)))
+	}
+	return strings.Join(flags, " ")
+}
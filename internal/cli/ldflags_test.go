@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/benny123tw/bumpkin/internal/executor"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+func TestLdflags(t *testing.T) {
+	v := version.Version{Major: 1, Minor: 4, Patch: 2}
+	desc := &executor.Description{
+		Tag:        "v1.4.2",
+		Version:    &v,
+		Commits:    5,
+		Hash:       "abc1234def5678abc1234def5678abc1234def56",
+		CommitTime: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+	}
+
+	assert.Equal(t,
+		"-X main.version=1.4.3-dev.5+abc1234",
+		ldflags(desc, "semver", "main.version", "", ""),
+	)
+	assert.Equal(t,
+		"-X main.version=v1.4.2-5-gabc1234 -X main.commit=abc1234 -X main.date=2024-01-02T15:04:05Z",
+		ldflags(desc, "git", "main.version", "main.commit", "main.date"),
+	)
+}
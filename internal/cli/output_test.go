@@ -56,3 +56,47 @@ func TestOutputJSON_OmitsEmptyPostPushWarnings(t *testing.T) {
 	require.NoError(t, outputJSON(cmd, result, nil))
 	assert.NotContains(t, buf.String(), "post_push_warnings")
 }
+
+func TestOutputJSON_IncludesPromotedFrom(t *testing.T) {
+	cmd := &cobra.Command{}
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+
+	result := &executor.Result{
+		PreviousVersion: "1.1.0-rc.2",
+		NewVersion:      "1.1.0",
+		TagName:         "v1.1.0",
+		CommitHash:      "abc1234",
+		PromotedFrom:    "v1.1.0-rc.2",
+		TagCreated:      true,
+	}
+
+	require.NoError(t, outputJSON(cmd, result, nil))
+
+	var out JSONOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, "v1.1.0-rc.2", out.PromotedFrom)
+}
+
+func TestOutputJSON_IncludesVersionWarnings(t *testing.T) {
+	cmd := &cobra.Command{}
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+
+	result := &executor.Result{
+		PreviousVersion: "3.2.0",
+		NewVersion:      "1.0.0",
+		TagName:         "v1.0.0",
+		CommitHash:      "abc1234",
+		TagCreated:      true,
+		Warnings:        []string{"gap"},
+		Forced:          []string{"1.0.0 is not greater than v3.2.0"},
+	}
+
+	require.NoError(t, outputJSON(cmd, result, nil))
+
+	var out JSONOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, []string{"gap"}, out.Warnings)
+	assert.Equal(t, []string{"1.0.0 is not greater than v3.2.0"}, out.Forced)
+}
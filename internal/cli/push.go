@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/benny123tw/bumpkin/internal/config"
+	"github.com/benny123tw/bumpkin/internal/executor"
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+// Environment variables for native push credentials
+const (
+	envGitUsername   = "BUMPKIN_GIT_USERNAME"
+	envGitToken      = "BUMPKIN_GIT_TOKEN"
+	envSSHPassphrase = "BUMPKIN_SSH_PASSPHRASE"
+)
+
+// addPushFlags registers the push transport flags on cmd
+func addPushFlags(cmd *cobra.Command) {
+	cmd.Flags().String(
+		"push-transport",
+		"",
+		"Push with the git binary or natively with go-git (overrides push.transport)",
+	)
+	cmd.Flags().StringArray(
+		"mirror",
+		nil,
+		"Also push to this remote, reporting failures as warnings (repeatable)",
+	)
+}
+
+// pushRemotes returns the remotes to push to: push.remotes unless --remote is
+// given, followed by the --mirror remotes. Without either the executor pushes
+// to the one remote.
+func pushRemotes(cmd *cobra.Command, cfg *config.Config) []executor.PushRemote {
+	var remotes []executor.PushRemote
+	if !cmd.Flags().Changed("remote") {
+		for _, remote := range cfg.Push.Remotes {
+			remotes = append(remotes, executor.PushRemote(remote))
+		}
+	}
+
+	mirrors, _ := cmd.Flags().GetStringArray("mirror")
+	if len(mirrors) > 0 && len(remotes) == 0 {
+		remote, _ := cmd.Flags().GetString("remote")
+		remotes = append(remotes, executor.PushRemote{Name: remote})
+	}
+	for _, mirror := range mirrors {
+		remotes = append(remotes, executor.PushRemote{Name: mirror, BestEffort: true})
+	}
+	return remotes
+}
+
+// primaryRemote returns the first remote pushed to, which hooks see as
+// BUMPKIN_REMOTE
+func primaryRemote(cmd *cobra.Command, remotes []executor.PushRemote) string {
+	if len(remotes) > 0 {
+		return remotes[0].Name
+	}
+	remote, _ := cmd.Flags().GetString("remote")
+	return remote
+}
+
+// setTransport configures native pushes from the flags, the config and the
+// environment. The git binary is used unless the transport is native.
+func setTransport(cmd *cobra.Command, repo *git.Repository, cfg *config.Config) error {
+	transport := cfg.Push.Transport
+	if flag, _ := cmd.Flags().GetString("push-transport"); flag != "" {
+		transport = flag
+	}
+
+	switch transport {
+	case "", "git":
+		return nil
+	case "native":
+	default:
+		return fmt.Errorf("unknown push transport %q (use git or native)", transport)
+	}
+
+	repo.SetNativeTransport(nativeTransport(cfg))
+	return nil
+}
+
+// nativeTransport returns the go-git transport settings from the config and
+// the environment
+func nativeTransport(cfg *config.Config) *git.NativeTransport {
+	native := &git.NativeTransport{
+		SSHKey:        cfg.Push.SSHKey,
+		SSHPassphrase: os.Getenv(envSSHPassphrase),
+		Username:      os.Getenv(envGitUsername),
+		Password:      os.Getenv(envGitToken),
+	}
+	if cfg.Push.KnownHosts != "" {
+		native.KnownHosts = []string{cfg.Push.KnownHosts}
+	}
+	return native
+}
+
+// pushExitCode returns the exit code for a failed release push, so scripts
+// can tell a taken version from a network failure, or ExitGeneralError
+func pushExitCode(err error) int {
+	switch {
+	case errors.Is(err, git.ErrRemoteTagExists):
+		return ExitTagExists
+	case errors.Is(err, git.ErrPushRejected):
+		return ExitPushRejected
+	case errors.Is(err, git.ErrRemoteUnreachable):
+		return ExitRemoteUnreachable
+	default:
+		return ExitGeneralError
+	}
+}
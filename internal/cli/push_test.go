@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRootCommand_NativePush(t *testing.T) {
+	remoteDir := t.TempDir()
+	ctx := context.Background()
+	require.NoError(t, exec.CommandContext(ctx, "git", "init", "--bare", remoteDir).Run())
+
+	tmpDir := t.TempDir()
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+	require.NoError(t, os.Chdir(tmpDir))
+
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-a", "v1.0.0", "-m", "Release v1.0.0"},
+		{"commit", "--allow-empty", "-m", "fix: bug"},
+		{"remote", "add", "origin", "file://" + remoteDir},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run())
+	}
+	require.NoError(t, os.WriteFile(".bumpkin.yaml", []byte("push:\n  transport: native\n"), 0o600))
+
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"--patch", "--yes"})
+	require.NoError(t, cmd.Execute())
+
+	out, err := exec.CommandContext(ctx, "git", "ls-remote", "--tags", remoteDir).Output()
+	require.NoError(t, err)
+	assert.True(t, strings.Contains(string(out), "refs/tags/v1.0.1"), string(out))
+
+	cmd = NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"--patch", "--yes", "--push-transport", "carrier-pigeon"})
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Equal(t, ExitInvalidArgs, GetExitCode(err))
+}
+
+func TestRootCommand_PushExitCodes(t *testing.T) {
+	remoteDir := t.TempDir()
+	ctx := context.Background()
+	require.NoError(t, exec.CommandContext(ctx, "git", "init", "--bare", remoteDir).Run())
+
+	tmpDir := t.TempDir()
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+	require.NoError(t, os.Chdir(tmpDir))
+
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-a", "v1.0.0", "-m", "Release v1.0.0"},
+		{"commit", "--allow-empty", "-m", "fix: bug"},
+		{"remote", "add", "origin", remoteDir},
+		{"push", "origin", "HEAD", "v1.0.0"},
+		// Another release took v1.0.1 after the tags were fetched
+		{"push", "origin", "v1.0.0^{commit}:refs/tags/v1.0.1"},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run(), args)
+	}
+
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"--patch", "--yes", "--no-fetch"})
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Equal(t, ExitTagExists, GetExitCode(err))
+
+	// Nothing was tagged locally
+	out, err := exec.CommandContext(ctx, "git", "tag", "--list", "v1.0.1").Output()
+	require.NoError(t, err)
+	assert.Empty(t, strings.TrimSpace(string(out)))
+
+	missing := filepath.Join(t.TempDir(), "missing.git")
+	require.NoError(t, exec.CommandContext(ctx, "git", "remote", "set-url", "origin", missing).Run())
+	cmd = NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"--patch", "--yes"})
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Equal(t, ExitRemoteUnreachable, GetExitCode(err))
+}
+
+func TestRootCommand_Mirror(t *testing.T) {
+	remoteDir := t.TempDir()
+	ctx := context.Background()
+	require.NoError(t, exec.CommandContext(ctx, "git", "init", "--bare", remoteDir).Run())
+
+	tmpDir := t.TempDir()
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+	require.NoError(t, os.Chdir(tmpDir))
+
+	missing := filepath.Join(t.TempDir(), "missing.git")
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-a", "v1.0.0", "-m", "Release v1.0.0"},
+		{"commit", "--allow-empty", "-m", "fix: bug"},
+		{"remote", "add", "origin", remoteDir},
+		{"remote", "add", "github", missing},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run(), args)
+	}
+
+	buf := new(bytes.Buffer)
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"--patch", "--yes", "--json", "--mirror", "github"})
+	require.NoError(t, cmd.Execute())
+
+	var out JSONOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.True(t, out.Pushed)
+	require.Len(t, out.Remotes, 2)
+	assert.Equal(t, remoteJSON{Remote: "origin", Pushed: true}, out.Remotes[0])
+	assert.Equal(t, "github", out.Remotes[1].Remote)
+	assert.True(t, out.Remotes[1].BestEffort)
+	assert.False(t, out.Remotes[1].Pushed)
+	require.Len(t, out.PushWarnings, 1)
+	assert.Contains(t, out.PushWarnings[0], "push to github failed")
+}
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+// addRepoFlag registers the global --repo flag on the root command, so every
+// subcommand can work on a repository other than the current directory's
+func addRepoFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(
+		"repo",
+		"",
+		"Path of the git repository to use, which may be bare (default: the current directory)",
+	)
+}
+
+// repoPath returns the --repo path, or "." for the current directory
+func repoPath(cmd *cobra.Command) string {
+	path, _ := cmd.Flags().GetString("repo")
+	if path == "" {
+		return "."
+	}
+	return path
+}
+
+// openRepo opens the --repo repository, or the one containing the current
+// directory
+func openRepo(cmd *cobra.Command) (*git.Repository, error) {
+	return git.OpenFrom(repoPath(cmd))
+}
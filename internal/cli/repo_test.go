@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRootCommand_BareRepo(t *testing.T) {
+	ctx := context.Background()
+	workDir := t.TempDir()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-a", "v1.0.0", "-m", "Release v1.0.0"},
+		{"commit", "--allow-empty", "-m", "fix: bug"},
+	} {
+		gitCmd := exec.CommandContext(ctx, "git", args...)
+		gitCmd.Dir = workDir
+		require.NoError(t, gitCmd.Run())
+	}
+	bareDir := filepath.Join(t.TempDir(), "release.git")
+	require.NoError(t, exec.CommandContext(ctx, "git", "clone", "--bare", workDir, bareDir).Run())
+	for _, args := range [][]string{
+		{"config", "user.email", "bot@test.com"},
+		{"config", "user.name", "Release Bot"},
+	} {
+		gitCmd := exec.CommandContext(ctx, "git", args...)
+		gitCmd.Dir = bareDir
+		require.NoError(t, gitCmd.Run())
+	}
+
+	// Run from a directory outside any repository
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+	require.NoError(t, os.Chdir(t.TempDir()))
+
+	buf := new(bytes.Buffer)
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"current", "--repo", bareDir})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "v1.0.0\n", buf.String())
+
+	cmd = NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"--conventional", "--yes", "--no-push", "--no-fetch", "--repo", bareDir})
+	require.NoError(t, cmd.Execute())
+
+	out, err := exec.CommandContext(ctx, "git", "-C", bareDir, "tag", "--list").Output()
+	require.NoError(t, err)
+	assert.Contains(t, strings.Fields(string(out)), "v1.0.1")
+
+	// The config is looked up in the --repo directory, and without a worktree
+	// its version file cannot be committed
+	config := "version-file:\n  path: version.go\n"
+	require.NoError(t, os.WriteFile(filepath.Join(bareDir, ".bumpkin.yaml"), []byte(config), 0o600))
+	cmd = NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{"--patch", "--yes", "--no-push", "--no-fetch", "--repo", bareDir})
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bare repository")
+}
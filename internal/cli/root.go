@@ -3,11 +3,15 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"syscall"
 
 	"github.com/charmbracelet/fang"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/spf13/cobra"
 
 	"github.com/benny123tw/bumpkin/internal/config"
@@ -29,7 +33,10 @@ var (
 	flagAlpha        bool
 	flagBeta         bool
 	flagRC           bool
+	flagPre          string
 	flagRelease      bool
+	flagPromote      bool
+	flagFrom         string
 
 	// Behavior flags
 	flagPrefix      string
@@ -38,6 +45,10 @@ var (
 	flagDryRun      bool
 	flagNoPush      bool
 	flagNoHooks     bool
+	flagForce       bool
+	flagReason      string
+	flagAt          string
+	flagBuild       string
 	flagYes         bool
 	flagJSON        bool
 	flagShowVersion bool
@@ -50,11 +61,26 @@ type JSONOutput struct {
 	NewVersion       string   `json:"new_version"`
 	TagName          string   `json:"tag_name"`
 	CommitHash       string   `json:"commit_hash"`
+	PromotedFrom     string   `json:"promoted_from,omitempty"`
 	TagCreated       bool     `json:"tag_created"`
 	Pushed           bool     `json:"pushed"`
 	DryRun           bool     `json:"dry_run"`
 	PostPushWarnings []string `json:"post_push_warnings,omitempty"`
+	Warnings         []string `json:"warnings,omitempty"`
+	Forced           []string `json:"forced,omitempty"`
+	FloatingTags     []string `json:"floating_tags,omitempty"`
 	Error            string   `json:"error,omitempty"`
+
+	Remotes      []remoteJSON `json:"remotes,omitempty"`
+	PushWarnings []string     `json:"push_warnings,omitempty"`
+}
+
+// remoteJSON is the outcome of the push to one remote
+type remoteJSON struct {
+	Remote     string `json:"remote"`
+	BestEffort bool   `json:"best_effort"`
+	Pushed     bool   `json:"pushed"`
+	Error      string `json:"error,omitempty"`
 }
 
 type rootCommand struct {
@@ -79,11 +105,18 @@ Run without flags for interactive mode, or use flags for automation.`,
 	}
 
 	addFlags(rootCmd)
+	addRepoFlag(rootCmd)
 
 	// Add subcommands
 	rootCmd.AddCommand(newVersionCommand(info).cmd)
 	rootCmd.AddCommand(newCurrentCommand().cmd)
+	rootCmd.AddCommand(newVersionsCommand().cmd)
+	rootCmd.AddCommand(newVerifyCommand().cmd)
 	rootCmd.AddCommand(newInitCommand().cmd)
+	rootCmd.AddCommand(newHotfixCommand().cmd)
+	rootCmd.AddCommand(newSnapshotCommand().cmd)
+	rootCmd.AddCommand(newDescribeCommand().cmd)
+	rootCmd.AddCommand(newLdflagsCommand().cmd)
 
 	c.cmd = rootCmd
 	return c
@@ -115,7 +148,15 @@ func addFlags(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&flagAlpha, "alpha", false, "Bump to alpha prerelease")
 	cmd.Flags().BoolVar(&flagBeta, "beta", false, "Bump to beta prerelease")
 	cmd.Flags().BoolVar(&flagRC, "rc", false, "Bump to release candidate")
+	cmd.Flags().StringVar(&flagPre, "pre", "", "Bump to prerelease on the given channel")
 	cmd.Flags().BoolVar(&flagRelease, "release", false, "Promote prerelease to release")
+	cmd.Flags().BoolVar(
+		&flagPromote,
+		"promote",
+		false,
+		"Release the latest prerelease at the commit it was tagged on",
+	)
+	cmd.Flags().StringVar(&flagFrom, "from", "", "Prerelease tag to promote (implies --promote)")
 
 	// Behavior flags
 	cmd.Flags().StringVarP(&flagPrefix, "prefix", "p", "v", "Tag prefix")
@@ -124,6 +165,34 @@ func addFlags(cmd *cobra.Command) {
 	cmd.Flags().BoolVarP(&flagDryRun, "dry-run", "d", false, "Preview without making changes")
 	cmd.Flags().BoolVar(&flagNoPush, "no-push", false, "Create tag but don't push")
 	cmd.Flags().BoolVar(&flagNoHooks, "no-hooks", false, "Skip hook execution")
+	cmd.Flags().BoolVar(
+		&flagForce,
+		"force",
+		false,
+		"Allow earlier prerelease channels, downgrades and already tagged versions",
+	)
+	cmd.Flags().StringVar(
+		&flagReason,
+		"reason",
+		"",
+		"Why --force is used, recorded in the tag message",
+	)
+	cmd.Flags().StringVar(&flagAt, "at", "", "Tag the given revision instead of HEAD (e.g. HEAD~2)")
+	cmd.Flags().StringVar(
+		&flagBuild,
+		"build",
+		"",
+		"Build metadata to append as +<meta> (overrides build.metadata in config)",
+	)
+	cmd.Flags().Bool(
+		"floating",
+		false,
+		"Move floating tags like v1 and v1.4 to stable releases (overrides tag.floating)",
+	)
+	addSignFlags(cmd)
+	addTagFlags(cmd)
+	addPushFlags(cmd)
+	addFetchFlags(cmd)
 	cmd.Flags().BoolVarP(&flagYes, "yes", "y", false, "Skip confirmation in non-interactive mode")
 	cmd.Flags().BoolVar(&flagJSON, "json", false, "Output result as JSON")
 	cmd.Flags().BoolVar(&flagShowVersion, "show-version", false, "Show version information")
@@ -142,59 +211,125 @@ func (c *rootCommand) runRoot(cmd *cobra.Command, _ []string) error {
 	}
 
 	// Load configuration and apply defaults for unset flags
-	var cfg *config.Config
-	var cfgErr error
-	configExplicit := cmd.Flags().Changed("config")
-	if configExplicit {
-		// Use explicitly specified config file
-		cfg, cfgErr = config.LoadFile(flagConfig)
-		if cfgErr != nil {
-			return fmt.Errorf("failed to load config file %q: %w", flagConfig, cfgErr)
-		}
-	} else {
-		// Search current directory for .bumpkin.yaml or .bumpkin.yml
-		cwd, err := os.Getwd()
-		if err != nil {
-			return fmt.Errorf("failed to get working directory: %w", err)
-		}
-		cfg, cfgErr = config.Load(cwd)
-		if cfgErr != nil {
-			// Auto-discovered config failed to load; warn and use defaults
-			fmt.Fprintf(
-				cmd.ErrOrStderr(),
-				"Warning: failed to load config: %v (using defaults)\n",
-				cfgErr,
-			)
-			cfg = config.Default()
-		}
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return err
 	}
 	applyConfigDefaults(cmd, cfg)
 
 	// Determine if we're in non-interactive mode
 	isNonInteractive := flagPatch || flagMinor || flagMajor || flagSetVersion != "" ||
-		flagConventional || flagAlpha || flagBeta || flagRC || flagRelease
+		flagConventional || flagAlpha || flagBeta || flagRC || flagPre != "" || flagRelease ||
+		flagPromote || flagFrom != ""
 
-	// Open the repository from current directory
-	repo, err := git.OpenFromCurrent()
+	// Open the repository from --repo or the current directory
+	repo, err := openRepo(cmd)
 	if err != nil {
 		return handleErrorWithCode(cmd, ExitNotGitRepo, "not a git repository", err)
 	}
+	if err := setScheme(repo, cfg); err != nil {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "invalid version scheme", err)
+	}
+	if err := setTagType(repo, cfg); err != nil {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "invalid tag config", err)
+	}
+	if err := setSigner(cmd, repo, cfg); err != nil {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "invalid signing config", err)
+	}
+	setTagger(cmd, repo)
+	if err := setTransport(cmd, repo, cfg); err != nil {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "invalid push config", err)
+	}
+	if err := fetchTags(cmd, repo, cfg); err != nil {
+		return handleFetchError(cmd, err)
+	}
 
 	if isNonInteractive {
 		return runNonInteractive(cmd, repo, cfg)
 	}
 
-	return runInteractive(repo, cfg)
+	return runInteractive(cmd, repo, cfg)
+}
+
+// loadConfig loads the --config file if given, otherwise searches the --repo
+// directory or the current one, falling back to defaults with a warning
+func loadConfig(cmd *cobra.Command) (*config.Config, error) {
+	if cmd.Flags().Changed("config") {
+		// Use explicitly specified config file
+		path, _ := cmd.Flags().GetString("config")
+		cfg, err := config.LoadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load config file %q: %w", path, err)
+		}
+		return cfg, nil
+	}
+
+	// Search the repository directory for .bumpkin.yaml or .bumpkin.yml
+	dir, err := filepath.Abs(repoPath(cmd))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get working directory: %w", err)
+	}
+	cfg, err := config.Load(dir)
+	if err != nil {
+		// Auto-discovered config failed to load; warn and use defaults
+		fmt.Fprintf(
+			cmd.ErrOrStderr(),
+			"Warning: failed to load config: %v (using defaults)\n",
+			err,
+		)
+		cfg = config.Default()
+	}
+	return cfg, nil
+}
+
+// setScheme makes the repository parse tags with the configured version scheme
+// and order prereleases by the configured channels
+func setScheme(repo *git.Repository, cfg *config.Config) error {
+	scheme, err := cfg.VersionScheme()
+	if err != nil {
+		return err
+	}
+	repo.SetScheme(scheme)
+	repo.SetChannels(cfg.Prerelease.Channels)
+	return nil
+}
+
+// setTagType sets the type of new release tags, and how existing tags of the
+// other type are treated, from the config
+func setTagType(repo *git.Repository, cfg *config.Config) error {
+	tagType, err := git.ParseTagType(cfg.Tag.Type)
+	if err != nil {
+		return err
+	}
+	policy, err := git.ParseTagTypePolicy(cfg.Tag.TypePolicy)
+	if err != nil {
+		return err
+	}
+	repo.SetTagType(tagType, policy)
+	return nil
 }
 
 // applyConfigDefaults applies config file values when flags aren't explicitly set
 func applyConfigDefaults(cmd *cobra.Command, cfg *config.Config) {
+	// Setting the value directly leaves the flag unchanged for later checks
 	if !cmd.Flags().Changed("prefix") && cfg.Prefix != "" {
-		flagPrefix = cfg.Prefix
+		//nolint:errcheck // A string flag accepts any value
+		cmd.Flags().Lookup("prefix").Value.Set(cfg.Prefix)
 	}
 	if !cmd.Flags().Changed("remote") && cfg.Remote != "" {
-		flagRemote = cfg.Remote
+		//nolint:errcheck // A string flag accepts any value
+		cmd.Flags().Lookup("remote").Value.Set(cfg.Remote)
+	}
+}
+
+// configPrefix returns the --prefix flag if it was set, otherwise the
+// prefix from the config
+func configPrefix(cmd *cobra.Command, cfg *config.Config) string {
+	if cmd.Flags().Changed("prefix") || cfg.Prefix == "" {
+		prefix, _ := cmd.Flags().GetString("prefix")
+		return prefix
 	}
+	return cfg.Prefix
 }
 
 // countTrueFlags counts the number of true values among the provided boolean flags.
@@ -210,6 +345,9 @@ func countTrueFlags(flags ...bool) int {
 }
 
 func runNonInteractive(cmd *cobra.Command, repo *git.Repository, cfg *config.Config) error {
+	// --from names the prerelease to promote
+	promote := flagPromote || flagFrom != ""
+
 	// Validate mutually exclusive flags
 	bumpCount := countTrueFlags(
 		flagPatch,
@@ -217,10 +355,8 @@ func runNonInteractive(cmd *cobra.Command, repo *git.Repository, cfg *config.Con
 		flagMajor,
 		flagSetVersion != "",
 		flagConventional,
-		flagAlpha,
-		flagBeta,
-		flagRC,
 		flagRelease,
+		promote,
 	)
 
 	if bumpCount > 1 {
@@ -232,18 +368,53 @@ func runNonInteractive(cmd *cobra.Command, repo *git.Repository, cfg *config.Con
 		)
 	}
 
-	// Determine bump type
-	var bumpType version.BumpType
-	var customVersion string
+	// Prerelease channel flags may be combined with --patch, --minor, --major
+	// or --conventional to start a prerelease of the next version
+	channelCount := countTrueFlags(flagAlpha, flagBeta, flagRC, flagPre != "")
+	if channelCount > 1 {
+		return handleErrorWithCode(
+			cmd,
+			ExitInvalidArgs,
+			"only one prerelease channel flag can be specified",
+			nil,
+		)
+	}
+	if channelCount > 0 && (flagSetVersion != "" || flagRelease || promote) {
+		return handleErrorWithCode(
+			cmd,
+			ExitInvalidArgs,
+			"prerelease channel flags cannot be combined with --set-version, --release or --promote",
+			nil,
+		)
+	}
+
+	if promote && flagAt != "" {
+		return handleErrorWithCode(
+			cmd,
+			ExitInvalidArgs,
+			"--promote cannot be combined with --at",
+			nil,
+		)
+	}
 
+	var channel string
 	switch {
 	case flagAlpha:
-		bumpType = version.BumpPrereleaseAlpha
+		channel = "alpha"
 	case flagBeta:
-		bumpType = version.BumpPrereleaseBeta
+		channel = "beta"
 	case flagRC:
-		bumpType = version.BumpPrereleaseRC
-	case flagRelease:
+		channel = "rc"
+	case flagPre != "":
+		channel = flagPre
+	}
+
+	// Determine bump type
+	var bumpType version.BumpType
+	var customVersion string
+
+	switch {
+	case flagRelease, promote:
 		bumpType = version.BumpRelease
 	case flagPatch:
 		bumpType = version.BumpPatch
@@ -255,40 +426,90 @@ func runNonInteractive(cmd *cobra.Command, repo *git.Repository, cfg *config.Con
 		bumpType = version.BumpCustom
 		customVersion = flagSetVersion
 	case flagConventional:
-		// Analyze commits to determine bump type
-		bumpType = analyzeConventionalCommits(repo)
+		// Analyze commits to determine bump type. A prerelease stays on its
+		// channel unless another channel is given.
+		if channel == "" {
+			prevVersion, err := latestVersion(repo)
+			if err != nil {
+				return handleError(cmd, err, "failed to get latest tag")
+			}
+			channel = activeChannel(repo, prevVersion)
+		}
+		bumpType = analyzeConventionalCommits(repo, channel)
+	default:
+		// Only a channel was given: bump the current prerelease line
+		bumpType = version.BumpPrereleaseChannel
+	}
+
+	if channel != "" && bumpType != version.BumpPrereleaseChannel && !flagConventional {
+		bumpType = bumpType.PreLevel()
+	}
+
+	// Calendar versions only move to the next date, so --minor, --major and
+	// prerelease flags are rejected up front
+	if scheme := repo.Scheme(); !scheme.Supports(bumpType) {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "", fmt.Errorf(
+			"%w: %s under %s", version.ErrUnsupportedBump, bumpType, scheme.Name(),
+		))
+	}
+
+	metadataPolicy, err := version.ParseMetadataPolicy(cfg.Build.Policy)
+	if err != nil {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "invalid build config", err)
+	}
+	verifier, err := tagVerifier(cfg)
+	if err != nil {
+		return handleErrorWithCode(cmd, ExitInvalidArgs, "invalid verify config", err)
+	}
+	remotes := pushRemotes(cmd, cfg)
+
+	req := executor.Request{
+		Repository:       repo,
+		BumpType:         bumpType,
+		CustomVersion:    customVersion,
+		Channel:          channel,
+		Channels:         cfg.Prerelease.Channels,
+		Force:            flagForce,
+		PrereleaseCompat: cfg.Prerelease.Compat,
+		Promote:          promote,
+		PromoteFrom:      flagFrom,
+		At:               flagAt,
+		VersionFile:      executor.VersionFile(cfg.VersionFile),
+		Prefix:           flagPrefix,
+		Remote:           primaryRemote(cmd, remotes),
+		DryRun:           flagDryRun,
+		NoPush:           flagNoPush,
+		NoHooks:          flagNoHooks,
+		PreTagHooks:      cfg.Hooks.PreTag,
+		PostTagHooks:     cfg.Hooks.PostTag,
+		PostPushHooks:    cfg.Hooks.PostPush,
+		HookTemplates:    cfg.Hooks.Templates,
+		BuildMetadata:    buildMetadata(cfg),
+		MetadataPolicy:   metadataPolicy,
+		ForceReason:      flagReason,
+		Floating:         floating(cmd, cfg),
+		Verifier:         verifier,
+		RequireSigned:    cfg.Tag.Verify.Required,
+		Message:          tagMessage(cmd, cfg),
+		Remotes:          remotes,
 	}
 
 	// If not --yes, require confirmation (unless dry-run)
 	if !flagYes && !flagDryRun {
 		// Get current version for display
-		latestTag, err := repo.LatestTag(flagPrefix)
+		prevVersion, newVersion, err := previewVersions(repo, req)
 		if err != nil {
-			return handleError(cmd, err, "failed to get latest tag")
-		}
-
-		var prevVersion version.Version
-		if latestTag == nil || latestTag.Version == nil {
-			prevVersion = version.Zero()
-		} else {
-			prevVersion = *latestTag.Version
-		}
-
-		var newVersion version.Version
-		if bumpType == version.BumpCustom {
-			newVersion, err = version.Parse(customVersion)
-			if err != nil {
-				return handleError(cmd, err, "invalid version")
+			if isForceableError(err) {
+				return handleForceableError(cmd, err)
 			}
-		} else {
-			newVersion = version.Bump(prevVersion, bumpType)
+			return handleError(cmd, err, "invalid version")
 		}
 
 		fmt.Fprintf(
 			cmd.OutOrStdout(),
 			"Will bump version: %s → %s\n",
-			prevVersion.String(),
-			newVersion.String(),
+			repo.Scheme().Format(prevVersion),
+			repo.Scheme().Format(newVersion),
 		)
 		fmt.Fprintf(
 			cmd.OutOrStdout(),
@@ -298,22 +519,17 @@ func runNonInteractive(cmd *cobra.Command, repo *git.Repository, cfg *config.Con
 	}
 
 	// Execute the bump
-	req := executor.Request{
-		Repository:    repo,
-		BumpType:      bumpType,
-		CustomVersion: customVersion,
-		Prefix:        flagPrefix,
-		Remote:        flagRemote,
-		DryRun:        flagDryRun,
-		NoPush:        flagNoPush,
-		NoHooks:       flagNoHooks,
-		PreTagHooks:   cfg.Hooks.PreTag,
-		PostTagHooks:  cfg.Hooks.PostTag,
-		PostPushHooks: cfg.Hooks.PostPush,
-	}
-
 	result, err := executor.Execute(cmd.Context(), req)
 	if err != nil {
+		if isForceableError(err) {
+			return handleForceableError(cmd, err)
+		}
+		if errors.Is(err, executor.ErrUntrustedTags) {
+			return handleErrorWithCode(cmd, ExitUnverified, "", err)
+		}
+		if code := pushExitCode(err); code != ExitGeneralError {
+			return handleErrorWithCode(cmd, code, "", err)
+		}
 		return handleError(cmd, err, "bump failed")
 	}
 
@@ -325,17 +541,128 @@ func runNonInteractive(cmd *cobra.Command, repo *git.Repository, cfg *config.Con
 	return outputText(cmd, result)
 }
 
-func runInteractive(repo *git.Repository, cfg *config.Config) error {
+// latestVersion returns the version of the latest tag, or 0.0.0 if there are no tags
+func latestVersion(repo *git.Repository) (version.Version, error) {
+	latestTag, _, err := latestTagAt(repo, flagPrefix, flagAt)
+	if err != nil {
+		return version.Version{}, err
+	}
+	if latestTag == nil || latestTag.Version == nil {
+		return version.Zero(), nil
+	}
+	return *latestTag.Version, nil
+}
+
+// latestTagAt returns the latest tag reachable from rev and the commit rev
+// resolves to. An empty rev uses the latest tag overall and a zero hash.
+func latestTagAt(repo *git.Repository, prefix, rev string) (*git.Tag, plumbing.Hash, error) {
+	if rev == "" {
+		tag, err := repo.LatestTag(prefix)
+		return tag, plumbing.ZeroHash, err
+	}
+
+	hash, err := repo.ResolveRevision(rev)
+	if err != nil {
+		return nil, plumbing.ZeroHash, err
+	}
+	tag, err := repo.LatestTagAt(prefix, hash)
+	return tag, hash, err
+}
+
+// previewVersions returns the current and new version the request would produce
+func previewVersions(
+	repo *git.Repository,
+	req executor.Request,
+) (version.Version, version.Version, error) {
+	if req.Promote {
+		source, err := executor.PromotionSource(repo, flagPrefix, req.PromoteFrom)
+		if err != nil {
+			return version.Version{}, version.Version{}, err
+		}
+		return *source.Version, version.BumpToRelease(*source.Version), nil
+	}
+
+	prevVersion, err := latestVersion(repo)
+	if err != nil {
+		return version.Version{}, version.Version{}, fmt.Errorf("failed to get latest tag: %w", err)
+	}
+
+	newVersion, err := executor.NextVersion(req, prevVersion)
+	if err != nil {
+		return version.Version{}, version.Version{}, err
+	}
+	return prevVersion, newVersion, nil
+}
+
+// buildMetadata returns the --build metadata, or the configured template
+func buildMetadata(cfg *config.Config) string {
+	if flagBuild != "" {
+		return flagBuild
+	}
+	return cfg.Build.Metadata
+}
+
+// floating reports whether floating tags are moved, from --floating or the config
+func floating(cmd *cobra.Command, cfg *config.Config) bool {
+	if cmd.Flags().Changed("floating") {
+		enabled, _ := cmd.Flags().GetBool("floating")
+		return enabled
+	}
+	return cfg.Tag.Floating
+}
+
+// isForceableError reports whether err is an invalid prerelease channel or a
+// new version that does not follow the existing tags
+func isForceableError(err error) bool {
+	return errors.Is(err, version.ErrUnknownChannel) ||
+		errors.Is(err, version.ErrChannelRegression) ||
+		errors.Is(err, executor.ErrVersionNotGreater) ||
+		errors.Is(err, executor.ErrDuplicateVersion)
+}
+
+// handleForceableError reports an invalid version bump as an argument error,
+// pointing at --force for the checks it overrides
+func handleForceableError(cmd *cobra.Command, err error) error {
+	if !errors.Is(err, version.ErrUnknownChannel) {
+		err = fmt.Errorf("%w (use --force to override)", err)
+	}
+	return handleErrorWithCode(cmd, ExitInvalidArgs, "", err)
+}
+
+func runInteractive(cmd *cobra.Command, repo *git.Repository, cfg *config.Config) error {
+	metadataPolicy, err := version.ParseMetadataPolicy(cfg.Build.Policy)
+	if err != nil {
+		return fmt.Errorf("invalid build config: %w", err)
+	}
+
+	verifier, err := tagVerifier(cfg)
+	if err != nil {
+		return fmt.Errorf("invalid verify config: %w", err)
+	}
+
+	remotes := pushRemotes(cmd, cfg)
 	tuiCfg := tui.Config{
 		Repository:    repo,
+		Channels:      cfg.Prerelease.Channels,
+		Compat:        cfg.Prerelease.Compat,
+		VersionFile:   executor.VersionFile(cfg.VersionFile),
 		Prefix:        flagPrefix,
-		Remote:        flagRemote,
+		Remote:        primaryRemote(cmd, remotes),
 		DryRun:        flagDryRun,
 		NoPush:        flagNoPush,
 		NoHooks:       flagNoHooks,
 		PreTagHooks:   cfg.Hooks.PreTag,
 		PostTagHooks:  cfg.Hooks.PostTag,
 		PostPushHooks: cfg.Hooks.PostPush,
+		HookTemplates: cfg.Hooks.Templates,
+		Remotes:       remotes,
+
+		BuildMetadata:  buildMetadata(cfg),
+		MetadataPolicy: metadataPolicy,
+		Floating:       floating(cmd, cfg),
+		Message:        tagMessage(cmd, cfg),
+		Verifier:       verifier,
+		RequireSigned:  cfg.Tag.Verify.Required,
 	}
 
 	return tui.Run(tuiCfg)
@@ -347,7 +674,7 @@ func handleError(cmd *cobra.Command, err error, context string) error {
 
 func handleErrorWithCode(cmd *cobra.Command, code int, message string, err error) error {
 	exitErr := NewExitError(code, message, err)
-	if flagJSON {
+	if asJSON, _ := cmd.Flags().GetBool("json"); asJSON {
 		//nolint:errcheck // Best effort output
 		outputJSON(cmd, nil, exitErr)
 	}
@@ -355,9 +682,15 @@ func handleErrorWithCode(cmd *cobra.Command, code int, message string, err error
 }
 
 func outputJSON(cmd *cobra.Command, result *executor.Result, err error) error {
+	return encodeJSON(cmd, newJSONOutput(cmd, result, err))
+}
+
+// newJSONOutput builds the JSON output for a bump result or error
+func newJSONOutput(cmd *cobra.Command, result *executor.Result, err error) JSONOutput {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
 	output := JSONOutput{
 		Success: err == nil,
-		DryRun:  flagDryRun,
+		DryRun:  dryRun,
 	}
 
 	if err != nil {
@@ -369,14 +702,27 @@ func outputJSON(cmd *cobra.Command, result *executor.Result, err error) error {
 		output.NewVersion = result.NewVersion
 		output.TagName = result.TagName
 		output.CommitHash = result.CommitHash
+		output.PromotedFrom = result.PromotedFrom
 		output.TagCreated = result.TagCreated
 		output.Pushed = result.Pushed
 		output.PostPushWarnings = result.PostPushWarnings
+		output.Warnings = result.Warnings
+		output.Forced = result.Forced
+		output.FloatingTags = result.FloatingTags
+		output.PushWarnings = result.PushWarnings
+		for _, remote := range result.Remotes {
+			output.Remotes = append(output.Remotes, remoteJSON(remote))
+		}
 	}
 
+	return output
+}
+
+// encodeJSON writes v as indented JSON to the command's output
+func encodeJSON(cmd *cobra.Command, v any) error {
 	encoder := json.NewEncoder(cmd.OutOrStdout())
 	encoder.SetIndent("", "  ")
-	return encoder.Encode(output)
+	return encoder.Encode(v)
 }
 
 // outputText writes a human-readable summary of a bump operation to the command's output.
@@ -385,14 +731,29 @@ func outputJSON(cmd *cobra.Command, result *executor.Result, err error) error {
 // and any post-push hook warnings.
 func outputText(cmd *cobra.Command, result *executor.Result) error {
 	out := cmd.OutOrStdout()
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	noPush, _ := cmd.Flags().GetBool("no-push")
 
-	if flagDryRun {
+	if dryRun {
 		fmt.Fprintln(out, "[DRY RUN]")
 	}
 
 	fmt.Fprintf(out, "Version: %s → %s\n", result.PreviousVersion, result.NewVersion)
 	fmt.Fprintf(out, "Tag: %s\n", result.TagName)
 	fmt.Fprintf(out, "Commit: %s\n", result.CommitHash[:7])
+	if result.PromotedFrom != "" {
+		fmt.Fprintf(out, "Promoted from: %s\n", result.PromotedFrom)
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(out, "Warning: %s\n", warning)
+	}
+	for _, forced := range result.Forced {
+		fmt.Fprintf(out, "Forced: %s\n", forced)
+	}
+
+	if len(result.FloatingTags) > 0 {
+		fmt.Fprintf(out, "Floating tags: %s\n", strings.Join(result.FloatingTags, ", "))
+	}
 
 	if result.TagCreated {
 		fmt.Fprintln(out, "Tag created: yes")
@@ -403,14 +764,28 @@ func outputText(cmd *cobra.Command, result *executor.Result) error {
 	switch {
 	case result.Pushed:
 		fmt.Fprintln(out, "Pushed: yes")
-	case flagNoPush:
+	case noPush:
 		fmt.Fprintln(out, "Pushed: no (--no-push)")
-	case flagDryRun:
+	case dryRun:
 		fmt.Fprintln(out, "Pushed: no (dry run)")
 	default:
 		fmt.Fprintln(out, "Pushed: no")
 	}
 
+	for _, remote := range result.Remotes {
+		if len(result.Remotes) == 1 {
+			break
+		}
+		if remote.Pushed {
+			fmt.Fprintf(out, "  %s: pushed\n", remote.Remote)
+		} else {
+			fmt.Fprintf(out, "  %s: failed\n", remote.Remote)
+		}
+	}
+	for _, warning := range result.PushWarnings {
+		fmt.Fprintf(out, "Warning: %s\n", warning)
+	}
+
 	// Display post-push hook warnings if any
 	if len(result.PostPushWarnings) > 0 {
 		fmt.Fprintln(out, "")
@@ -438,32 +813,53 @@ func Execute(info BuildInfo) {
 	}
 }
 
-// analyzeConventionalCommits analyzes commits and returns recommended bump type
-func analyzeConventionalCommits(repo *git.Repository) version.BumpType {
-	// Get latest tag
-	latestTag, err := repo.LatestTag(flagPrefix)
-	if err != nil {
-		return version.BumpPatch // Default on error
-	}
+// analyzeConventionalCommits analyzes commits and returns recommended bump type.
+// With a channel the change goes onto a prerelease: the current prerelease
+// continues when it already covers the change, otherwise a pre-level bump
+// starts a new line.
+func analyzeConventionalCommits(repo *git.Repository, channel string) version.BumpType {
+	// Get latest tag, only analyzing commits up to --at if given
+	latestTag, target, err := latestTagAt(repo, flagPrefix, flagAt)
 
 	var commits []*git.Commit
-	if latestTag != nil {
+	switch {
+	case err != nil:
+		// Analyze no commits, which defaults to patch
+	case target.IsZero() && latestTag != nil:
 		commits, err = repo.GetCommitsSinceTag(latestTag.Name)
-	} else {
+	case target.IsZero():
 		commits, err = repo.GetAllCommits()
+	case latestTag != nil:
+		commits, err = repo.GetCommitsSinceTagAt(latestTag.Name, target)
+	default:
+		commits, err = repo.GetAllCommitsAt(target)
 	}
 
-	if err != nil || len(commits) == 0 {
-		return version.BumpPatch // Default
-	}
-
-	// Extract commit messages
+	// Extract commit messages; without any the analysis defaults to patch
 	var messages []string
 	for _, c := range commits {
 		messages = append(messages, c.Message)
 	}
 
-	// Analyze and return recommended bump
+	// Analyze and return the recommended bump for the version scheme
 	analysis := conventional.AnalyzeCommits(messages)
-	return analysis.RecommendedBump
+	if channel == "" {
+		return repo.Scheme().Recommend(analysis.RecommendedBump)
+	}
+	prevVersion := version.Zero()
+	if latestTag != nil && latestTag.Version != nil {
+		prevVersion = *latestTag.Version
+	}
+	return analysis.RecommendedPrerelease(prevVersion)
+}
+
+// activeChannel returns the channel of a prerelease on a configured channel,
+// or an empty string for releases and schemes without prereleases
+func activeChannel(repo *git.Repository, v version.Version) string {
+	channel := v.PrereleaseType()
+	if !v.IsPrerelease() || !repo.Scheme().Supports(version.BumpPrereleaseChannel) ||
+		!repo.Channels().Contains(channel) {
+		return ""
+	}
+	return channel
 }
@@ -2,6 +2,9 @@ package cli
 
 import (
 	"bytes"
+	"context"
+	"os/exec"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -74,3 +77,42 @@ func TestRootCommand_VersionFlag(t *testing.T) {
 	output := buf.String()
 	assert.Contains(t, output, "bumpkin")
 }
+
+func TestRootCommand_ConventionalPrerelease(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	runInDir := func(args ...string) {
+		t.Helper()
+		gitCmd := exec.CommandContext(ctx, "git", args...)
+		gitCmd.Dir = dir
+		require.NoError(t, gitCmd.Run(), "git %v", args)
+	}
+	runInDir("init")
+	runInDir("config", "user.email", "test@test.com")
+	runInDir("config", "user.name", "Test")
+	runInDir("commit", "--allow-empty", "-m", "initial")
+	runInDir("tag", "-a", "v1.3.0-beta.0", "-m", "Release v1.3.0-beta.0")
+
+	bump := func(args ...string) {
+		t.Helper()
+		cmd := NewRootCmd(testBuildInfo())
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetArgs(append(args, "--yes", "--no-push", "--no-fetch", "--repo", dir))
+		require.NoError(t, cmd.Execute())
+	}
+
+	// A prerelease stays on its channel when it already covers the change
+	runInDir("commit", "--allow-empty", "-m", "feat: more")
+	bump("--conventional")
+
+	// An explicit channel moves the prerelease onto it
+	runInDir("commit", "--allow-empty", "-m", "fix: bug")
+	bump("--conventional", "--rc")
+
+	out, err := exec.CommandContext(ctx, "git", "-C", dir, "tag", "--list").Output()
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{"v1.3.0-beta.0", "v1.3.0-beta.1", "v1.3.0-rc.0"},
+		strings.Fields(string(out)),
+	)
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/benny123tw/bumpkin/internal/config"
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+// Environment variables for signing in CI, where keys come from secrets
+const (
+	envSigningKey        = "BUMPKIN_SIGNING_KEY"
+	envSigningPassphrase = "BUMPKIN_SIGNING_PASSPHRASE"
+)
+
+// addSignFlags registers the tag signing flags on cmd
+func addSignFlags(cmd *cobra.Command) {
+	cmd.Flags().String(
+		"sign-format",
+		"",
+		"Sign tags with openpgp or ssh (overrides tag.sign.format)",
+	)
+	cmd.Flags().String(
+		"sign-key",
+		"",
+		"Signing key file, keyring or inline key (overrides tag.sign.key)",
+	)
+}
+
+// setSigner configures tag signing from the flags, the config and the
+// environment. Signing stays off unless a format is set; a key without a
+// format uses OpenPGP.
+func setSigner(cmd *cobra.Command, repo *git.Repository, cfg *config.Config) error {
+	sign := cfg.Tag.Sign
+	if format, _ := cmd.Flags().GetString("sign-format"); format != "" {
+		sign.Format = format
+	}
+	if key, _ := cmd.Flags().GetString("sign-key"); key != "" {
+		sign.Key = key
+		if sign.Format == "" {
+			sign.Format = git.SignOpenPGP
+		}
+	}
+	if sign.Format == "" {
+		return nil
+	}
+	if repo.TagType() == git.TagLightweight {
+		return fmt.Errorf("lightweight tags cannot be signed")
+	}
+	if sign.Key == "" {
+		sign.Key = os.Getenv(envSigningKey)
+	}
+
+	signer, err := git.LoadSigner(sign.Format, sign.Key, sign.KeyID, os.Getenv(envSigningPassphrase))
+	if err != nil {
+		return err
+	}
+	repo.SetSigner(signer)
+	return nil
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRootCommand_SignSSH(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen not installed")
+	}
+
+	keyDir := t.TempDir()
+	keyPath := filepath.Join(keyDir, "id_ed25519")
+	ctx := context.Background()
+	require.NoError(t, exec.CommandContext(
+		ctx, "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyPath,
+	).Run())
+	pub, err := os.ReadFile(keyPath + ".pub")
+	require.NoError(t, err)
+	allowedSigners := filepath.Join(keyDir, "allowed_signers")
+	require.NoError(t, os.WriteFile(allowedSigners, append([]byte("test@test.com "), pub...), 0o600))
+
+	tmpDir := t.TempDir()
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+	require.NoError(t, os.Chdir(tmpDir))
+
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-a", "v1.0.0", "-m", "Release v1.0.0"},
+		{"commit", "--allow-empty", "-m", "fix: bug"},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run())
+	}
+
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{
+		"--patch", "--yes", "--no-push", "--sign-format", "ssh", "--sign-key", keyPath,
+	})
+	require.NoError(t, cmd.Execute())
+
+	out, err := exec.CommandContext(
+		ctx, "git",
+		"-c", "gpg.format=ssh",
+		"-c", "gpg.ssh.allowedSignersFile="+allowedSigners,
+		"tag", "-v", "v1.0.1",
+	).CombinedOutput()
+	require.NoError(t, err, string(out))
+	assert.Contains(t, string(out), `Good "git" signature`)
+}
+
+func TestRootCommand_SignInvalidKey(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+	require.NoError(t, os.Chdir(tmpDir))
+
+	ctx := context.Background()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run())
+	}
+
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{
+		"--patch", "--yes", "--no-push", "--sign-key", filepath.Join(tmpDir, "missing.asc"),
+	})
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Equal(t, ExitInvalidArgs, GetExitCode(err))
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/benny123tw/bumpkin/internal/executor"
+)
+
+// snapshotJSONOutput is the JSON output of the snapshot command
+type snapshotJSONOutput struct {
+	Version         string `json:"version"`
+	TagName         string `json:"tag_name"`
+	PreviousVersion string `json:"previous_version"`
+	NextVersion     string `json:"next_version"`
+	Branch          string `json:"branch"`
+	Commits         int    `json:"commits"`
+	CommitHash      string `json:"commit_hash"`
+	TagCreated      bool   `json:"tag_created"`
+}
+
+type snapshotCommand struct {
+	cmd *cobra.Command
+}
+
+// newSnapshotCommand creates a command that prints a unique per-commit version
+// derived from the branch name, for preview builds on feature branches.
+func newSnapshotCommand() *snapshotCommand {
+	c := &snapshotCommand{}
+
+	snapshotCmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Print a snapshot version for the current branch",
+		Long: `Print a unique version for HEAD, such as 1.5.0-feat-login.7+gabc1234.
+
+The base is the next version recommended by conventional commits since the
+latest tag. The prerelease is the sanitized branch name followed by the number
+of commits since that tag, and the build metadata is the short commit hash.
+
+Nothing is tagged unless --tag is given, which creates a lightweight tag.`,
+		Args: cobra.NoArgs,
+		RunE: c.execute,
+	}
+
+	snapshotCmd.Flags().StringP("prefix", "p", "v", "Tag prefix")
+	snapshotCmd.Flags().String("branch", "", "Branch name to use (default: current branch)")
+	snapshotCmd.Flags().Bool("tag", false, "Create a lightweight tag for the snapshot")
+	snapshotCmd.Flags().Bool("json", false, "Output result as JSON")
+
+	c.cmd = snapshotCmd
+	return c
+}
+
+func (c *snapshotCommand) execute(cmd *cobra.Command, _ []string) error {
+	branch, _ := cmd.Flags().GetString("branch")
+	createTag, _ := cmd.Flags().GetBool("tag")
+	asJSON, _ := cmd.Flags().GetBool("json")
+
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+	prefix := configPrefix(cmd, cfg)
+
+	repo, err := openRepo(cmd)
+	if err != nil {
+		return NewExitError(ExitNotGitRepo, "not a git repository", err)
+	}
+	if err := setScheme(repo, cfg); err != nil {
+		return NewExitError(ExitInvalidArgs, "invalid version scheme", err)
+	}
+
+	result, err := executor.Snapshot(executor.SnapshotRequest{
+		Repository: repo,
+		Branch:     branch,
+		Prefix:     prefix,
+		CreateTag:  createTag,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot: %w", err)
+	}
+
+	if asJSON {
+		return encodeJSON(cmd, snapshotJSONOutput{
+			Version:         result.Version,
+			TagName:         result.TagName,
+			PreviousVersion: result.PreviousVersion,
+			NextVersion:     result.NextVersion,
+			Branch:          result.Branch,
+			Commits:         result.Commits,
+			CommitHash:      result.CommitHash,
+			TagCreated:      result.TagCreated,
+		})
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), result.Version)
+	if result.TagCreated {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Created tag %s\n", result.TagName)
+	}
+	return nil
+}
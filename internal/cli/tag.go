@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/benny123tw/bumpkin/internal/config"
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+// addTagFlags registers the tagger and tag message flags on cmd
+func addTagFlags(cmd *cobra.Command) {
+	cmd.Flags().String("tagger-name", "", "Tagger name (default: git config user.name)")
+	cmd.Flags().String("tagger-email", "", "Tagger email (default: git config user.email)")
+	cmd.Flags().String(
+		"message",
+		"",
+		"Tag message template with the changelog and commit data (overrides tag.message)",
+	)
+}
+
+// setTagger sets the tagger identity from the flags. Unset fields fall back
+// to GIT_COMMITTER_NAME and GIT_COMMITTER_EMAIL, then the git config.
+func setTagger(cmd *cobra.Command, repo *git.Repository) {
+	name, _ := cmd.Flags().GetString("tagger-name")
+	email, _ := cmd.Flags().GetString("tagger-email")
+	repo.SetTagger(git.Identity{Name: name, Email: email})
+}
+
+// tagMessage returns the tag message template from --message or the config
+func tagMessage(cmd *cobra.Command, cfg *config.Config) string {
+	if message, _ := cmd.Flags().GetString("message"); message != "" {
+		return message
+	}
+	return cfg.Tag.Message
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRootCommand_TaggerAndMessage(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+	require.NoError(t, os.Chdir(tmpDir))
+
+	ctx := context.Background()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-a", "v1.0.0", "-m", "Release v1.0.0"},
+		{"commit", "--allow-empty", "-m", "fix: bug"},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run())
+	}
+
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{
+		"--patch", "--yes", "--no-push",
+		"--tagger-name", "Release Bot",
+		"--tagger-email", "bot@test.com",
+		"--message", "{{.Tag}}: {{.Fixes}} fix by {{join .Contributors \", \"}}",
+	})
+	require.NoError(t, cmd.Execute())
+
+	out, err := exec.CommandContext(
+		ctx, "git", "for-each-ref",
+		"--format=%(taggername) %(taggeremail)|%(contents:subject)",
+		"refs/tags/v1.0.1",
+	).Output()
+	require.NoError(t, err)
+	assert.Equal(t, "Release Bot <bot@test.com>|v1.0.1: 1 fix by Test\n", string(out))
+}
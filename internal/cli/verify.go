@@ -0,0 +1,182 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/benny123tw/bumpkin/internal/config"
+	"github.com/benny123tw/bumpkin/internal/executor"
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+// verifyJSONOutput is one tag in the JSON output of the verify command
+type verifyJSONOutput struct {
+	Tag      string `json:"tag"`
+	Verified bool   `json:"verified"`
+	Format   string `json:"format,omitempty"`
+	Key      string `json:"key,omitempty"`
+	Signer   string `json:"signer,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+type verifyCommand struct {
+	cmd *cobra.Command
+}
+
+// newVerifyCommand creates a command that checks release tags are signed by
+// trusted keys
+func newVerifyCommand() *verifyCommand {
+	c := &verifyCommand{}
+
+	verifyCmd := &cobra.Command{
+		Use:   "verify [tag...]",
+		Short: "Verify that release tags are signed by trusted keys",
+		Long: `Verify the signatures of release tags against an allowed signers file
+(SSH signatures) and an OpenPGP keyring.
+
+Without arguments, every version tag reachable from HEAD is checked. Unsigned
+tags, bad signatures and keys that are not trusted are reported, and the
+command exits with code 7 if any tag fails.`,
+		Example: `  bumpkin verify --allowed-signers .allowed_signers
+  bumpkin verify v1.4.0 v1.4.1 --keyring release-keys.asc`,
+		RunE: c.execute,
+	}
+
+	verifyCmd.Flags().StringP("prefix", "p", "v", "Tag prefix to filter versions")
+	verifyCmd.Flags().String(
+		"allowed-signers",
+		"",
+		"Allowed signers file for SSH signatures (overrides tag.verify.allowed-signers)",
+	)
+	verifyCmd.Flags().String(
+		"keyring",
+		"",
+		"OpenPGP keyring of trusted keys (overrides tag.verify.keyring)",
+	)
+	verifyCmd.Flags().Bool("json", false, "Output results as JSON")
+
+	c.cmd = verifyCmd
+	return c
+}
+
+func (c *verifyCommand) execute(cmd *cobra.Command, args []string) error {
+	asJSON, _ := cmd.Flags().GetBool("json")
+
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+	prefix := configPrefix(cmd, cfg)
+
+	verifyCfg := cfg.Tag.Verify
+	if path, _ := cmd.Flags().GetString("allowed-signers"); path != "" {
+		verifyCfg.AllowedSigners = path
+	}
+	if path, _ := cmd.Flags().GetString("keyring"); path != "" {
+		verifyCfg.Keyring = path
+	}
+	verifier, err := git.LoadVerifier(verifyCfg.AllowedSigners, verifyCfg.Keyring)
+	if err != nil {
+		return NewExitError(ExitInvalidArgs, "invalid verify config", err)
+	}
+
+	repo, err := openRepo(cmd)
+	if err != nil {
+		return NewExitError(ExitNotGitRepo, "not a git repository", err)
+	}
+	if err := setScheme(repo, cfg); err != nil {
+		return NewExitError(ExitInvalidArgs, "invalid version scheme", err)
+	}
+	if err := setTagType(repo, cfg); err != nil {
+		return NewExitError(ExitInvalidArgs, "invalid tag config", err)
+	}
+
+	tags, err := tagsToVerify(repo, prefix, args)
+	if err != nil {
+		return NewExitError(ExitInvalidArgs, "", err)
+	}
+
+	results := executor.VerifyTags(repo, tags, verifier)
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+
+	if asJSON {
+		output := make([]verifyJSONOutput, len(results))
+		for i, result := range results {
+			output[i] = verifyJSONOutput{
+				Tag:      result.Tag,
+				Verified: result.Err == nil,
+				Format:   tags[i].SignatureFormat,
+				Key:      result.Key,
+				Signer:   result.Signer,
+			}
+			if result.Err != nil {
+				output[i].Error = result.Err.Error()
+			}
+		}
+		if err := encodeJSON(cmd, output); err != nil {
+			return err
+		}
+	} else {
+		out := cmd.OutOrStdout()
+		for _, result := range results {
+			if result.Err != nil {
+				fmt.Fprintf(out, "%s: FAILED (%v)\n", result.Tag, result.Err)
+				continue
+			}
+			fmt.Fprintf(out, "%s: verified, signed by %s (%s)\n",
+				result.Tag, result.Signer, result.Key)
+		}
+	}
+
+	if failed > 0 {
+		return NewExitError(
+			ExitUnverified, "",
+			fmt.Errorf("%d of %d tags failed verification", failed, len(results)),
+		)
+	}
+	return nil
+}
+
+// tagsToVerify returns the named tags, or the version tags reachable from HEAD
+func tagsToVerify(repo *git.Repository, prefix string, names []string) ([]*git.Tag, error) {
+	if len(names) == 0 {
+		head, err := repo.GetHEAD()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get HEAD: %w", err)
+		}
+		tags, err := repo.TagsAt(prefix, head)
+		if err != nil {
+			return nil, err
+		}
+		if len(tags) == 0 {
+			return nil, fmt.Errorf("no version tags with prefix %q to verify", prefix)
+		}
+		return tags, nil
+	}
+
+	tags := make([]*git.Tag, 0, len(names))
+	for _, name := range names {
+		tag, err := repo.FindTag(name)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// tagVerifier returns the verifier for the release pre-flight check, or nil
+// when no trusted keys are configured
+func tagVerifier(cfg *config.Config) (git.Verifier, error) {
+	verifyCfg := cfg.Tag.Verify
+	if verifyCfg.AllowedSigners == "" && verifyCfg.Keyring == "" {
+		return nil, nil
+	}
+	return git.LoadVerifier(verifyCfg.AllowedSigners, verifyCfg.Keyring)
+}
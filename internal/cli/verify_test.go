@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyCommand(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen not installed")
+	}
+
+	keyDir := t.TempDir()
+	keyPath := filepath.Join(keyDir, "id_ed25519")
+	ctx := context.Background()
+	require.NoError(t, exec.CommandContext(
+		ctx, "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyPath,
+	).Run())
+	pub, err := os.ReadFile(keyPath + ".pub")
+	require.NoError(t, err)
+	allowedSigners := filepath.Join(keyDir, "allowed_signers")
+	require.NoError(t, os.WriteFile(allowedSigners, append([]byte("test@test.com "), pub...), 0o600))
+
+	tmpDir := t.TempDir()
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+	require.NoError(t, os.Chdir(tmpDir))
+
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"config", "gpg.format", "ssh"},
+		{"config", "user.signingkey", keyPath},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-s", "v1.0.0", "-m", "Release v1.0.0"},
+		{"commit", "--allow-empty", "-m", "fix: bug"},
+		{"tag", "-s", "v1.0.1", "-m", "Release v1.0.1"},
+	} {
+		out, err := exec.CommandContext(ctx, "git", args...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	buf := new(bytes.Buffer)
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"verify", "--allowed-signers", allowedSigners, "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var out []verifyJSONOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	require.Len(t, out, 2)
+	assert.Equal(t, "v1.0.0", out[0].Tag)
+	assert.True(t, out[0].Verified)
+	assert.Equal(t, "ssh", out[0].Format)
+	assert.Equal(t, "test@test.com", out[1].Signer)
+
+	// An unsigned release breaks the chain
+	require.NoError(t, exec.CommandContext(
+		ctx, "git", "commit", "--allow-empty", "-m", "fix: another",
+	).Run())
+	require.NoError(t, exec.CommandContext(
+		ctx, "git", "tag", "-a", "v1.0.2", "-m", "Release v1.0.2",
+	).Run())
+
+	buf.Reset()
+	cmd = NewRootCmd(testBuildInfo())
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"verify", "--allowed-signers", allowedSigners})
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Equal(t, ExitUnverified, GetExitCode(err))
+	assert.Contains(t, buf.String(), "v1.0.2: FAILED (tag is not signed)")
+}
@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+// versionJSONOutput is one version tag in the JSON output of the versions command
+type versionJSONOutput struct {
+	Tag        string `json:"tag"`
+	Version    string `json:"version"`
+	CommitHash string `json:"commit_hash"`
+	Prerelease bool   `json:"prerelease"`
+}
+
+type versionsCommand struct {
+	cmd *cobra.Command
+}
+
+// newVersionsCommand creates a command that lists version tags matching a
+// semver constraint, sorted by semver precedence.
+func newVersionsCommand() *versionsCommand {
+	c := &versionsCommand{}
+
+	versionsCmd := &cobra.Command{
+		Use:   "versions",
+		Short: "List version tags, optionally filtered by a constraint",
+		Long: `List version tags sorted by semver, oldest first.
+
+--constraint filters with a semver range such as ^1.4, ~2.1, 2.x or
+">=1.2, <2.0". Prereleases are left out unless --prereleases is given or the
+constraint names a prerelease itself (">=2.0.0-0").`,
+		Example: `  bumpkin versions --constraint '^1.4'
+  bumpkin versions --constraint 2.x --prereleases --latest
+  bumpkin versions --json`,
+		Args: cobra.NoArgs,
+		RunE: c.execute,
+	}
+
+	versionsCmd.Flags().StringP("prefix", "p", "v", "Tag prefix to filter versions")
+	versionsCmd.Flags().String("constraint", "", "Semver range the versions must satisfy")
+	versionsCmd.Flags().Bool("prereleases", false, "Include prerelease versions")
+	versionsCmd.Flags().Bool("latest", false, "Only show the highest matching version")
+	versionsCmd.Flags().Bool("json", false, "Output versions as JSON")
+
+	c.cmd = versionsCmd
+	return c
+}
+
+func (c *versionsCommand) execute(cmd *cobra.Command, _ []string) error {
+	constraintFlag, _ := cmd.Flags().GetString("constraint")
+	prereleases, _ := cmd.Flags().GetBool("prereleases")
+	latest, _ := cmd.Flags().GetBool("latest")
+	asJSON, _ := cmd.Flags().GetBool("json")
+
+	var constraint *version.Constraint
+	if constraintFlag != "" {
+		var err error
+		constraint, err = version.ParseConstraint(constraintFlag, prereleases)
+		if err != nil {
+			return NewExitError(ExitInvalidArgs, "", err)
+		}
+	}
+
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+	prefix := configPrefix(cmd, cfg)
+
+	repo, err := openRepo(cmd)
+	if err != nil {
+		return NewExitError(ExitNotGitRepo, "not a git repository", err)
+	}
+	if err := setScheme(repo, cfg); err != nil {
+		return NewExitError(ExitInvalidArgs, "invalid version scheme", err)
+	}
+
+	tags, err := repo.ListTags()
+	if err != nil {
+		return fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	matches := matchingTags(tags, prefix, constraint, prereleases, repo.Channels())
+	if latest && len(matches) > 0 {
+		matches = matches[len(matches)-1:]
+	}
+
+	if asJSON {
+		output := make([]versionJSONOutput, len(matches))
+		for i, tag := range matches {
+			output[i] = versionJSONOutput{
+				Tag:        tag.Name,
+				Version:    repo.Scheme().Format(*tag.Version),
+				CommitHash: tag.CommitHash,
+				Prerelease: tag.Version.IsPrerelease(),
+			}
+		}
+		return encodeJSON(cmd, output)
+	}
+
+	for _, tag := range matches {
+		fmt.Fprintln(cmd.OutOrStdout(), tag.Name)
+	}
+	return nil
+}
+
+// matchingTags returns the version tags with the prefix that satisfy the
+// constraint, sorted by semver precedence with prereleases in channel order.
+// Without a constraint, prereleases are only included when requested.
+func matchingTags(
+	tags []*git.Tag,
+	prefix string,
+	constraint *version.Constraint,
+	prereleases bool,
+	channels version.Channels,
+) []*git.Tag {
+	var matches []*git.Tag
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag.Name, prefix) || tag.Version == nil {
+			continue
+		}
+
+		switch {
+		case constraint != nil && !constraint.Check(*tag.Version):
+			continue
+		case constraint == nil && !prereleases && tag.Version.IsPrerelease():
+			continue
+		}
+		matches = append(matches, tag)
+	}
+
+	slices.SortStableFunc(matches, func(a, b *git.Tag) int {
+		return channels.Compare(*a.Version, *b.Version)
+	})
+	return matches
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupVersionsRepo(t *testing.T) {
+	t.Helper()
+	tmpDir := t.TempDir()
+
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.Chdir(originalDir)
+	})
+
+	require.NoError(t, os.Chdir(tmpDir))
+
+	ctx := context.Background()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "v1.10.0"},
+		{"tag", "v1.4.0"},
+		{"tag", "v1.4.2"},
+		{"tag", "v2.0.0"},
+		{"tag", "v2.1.0-rc.1"},
+		{"tag", "v2.1.0-beta.2"},
+		{"tag", "nightly"},
+	} {
+		require.NoError(t, exec.CommandContext(ctx, "git", args...).Run())
+	}
+}
+
+func runVersions(t *testing.T, args ...string) string {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(buf)
+	cmd.SetArgs(append([]string{"versions"}, args...))
+	require.NoError(t, cmd.Execute())
+	return buf.String()
+}
+
+func TestVersionsCommand(t *testing.T) {
+	setupVersionsRepo(t)
+
+	assert.Equal(t, "v1.4.0\nv1.4.2\nv1.10.0\nv2.0.0\n", runVersions(t))
+	assert.Equal(t, "v1.4.0\nv1.4.2\nv1.10.0\n", runVersions(t, "--constraint", "^1.4"))
+	assert.Equal(t,
+		"v2.0.0\nv2.1.0-beta.2\nv2.1.0-rc.1\n",
+		runVersions(t, "--constraint", "2.x", "--prereleases"),
+	)
+	assert.Equal(t,
+		"v2.1.0-rc.1\n",
+		runVersions(t, "--constraint", "2.x", "--prereleases", "--latest"),
+	)
+}
+
+func TestVersionsCommand_ConfigPrefix(t *testing.T) {
+	setupVersionsRepo(t)
+	require.NoError(t, os.WriteFile(".bumpkin.yaml", []byte("prefix: v1.4\n"), 0o600))
+
+	// The config prefix is used unless --prefix is given
+	assert.Equal(t, "v1.4.0\nv1.4.2\n", runVersions(t))
+	assert.Equal(t, "v2.0.0\n", runVersions(t, "--prefix", "v", "--latest"))
+}
+
+func TestVersionsCommand_JSON(t *testing.T) {
+	setupVersionsRepo(t)
+
+	var output []versionJSONOutput
+	out := runVersions(t, "--constraint", "~1.4", "--json")
+	require.NoError(t, json.Unmarshal([]byte(out), &output))
+
+	require.Len(t, output, 2)
+	assert.Equal(t, "v1.4.0", output[0].Tag)
+	assert.Equal(t, "1.4.2", output[1].Version)
+	assert.False(t, output[1].Prerelease)
+	assert.Len(t, output[1].CommitHash, 40)
+}
+
+func TestVersionsCommand_InvalidConstraint(t *testing.T) {
+	cmd := NewRootCmd(testBuildInfo())
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"versions", "--constraint", "^^1"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Equal(t, ExitInvalidArgs, GetExitCode(err))
+}
@@ -6,13 +6,139 @@ import (
 	"path/filepath"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
 )
 
 // Config represents the bumpkin configuration
 type Config struct {
-	Prefix string `yaml:"prefix"`
-	Remote string `yaml:"remote"`
-	Hooks  Hooks  `yaml:"hooks"`
+	Prefix      string      `yaml:"prefix"`
+	Remote      string      `yaml:"remote"`
+	Scheme      string      `yaml:"scheme"`
+	CalVer      CalVer      `yaml:"calver"`
+	Prerelease  Prerelease  `yaml:"prerelease"`
+	Build       Build       `yaml:"build"`
+	Tag         Tag         `yaml:"tag"`
+	Push        Push        `yaml:"push"`
+	Fetch       Fetch       `yaml:"fetch"`
+	VersionFile VersionFile `yaml:"version-file"`
+	Hooks       Hooks       `yaml:"hooks"`
+}
+
+// CalVer contains calendar versioning settings, used when Scheme is "calver"
+type CalVer struct {
+	// Format is the layout, e.g. YYYY.0M.MICRO or YY.0M.MICRO
+	Format string `yaml:"format"`
+}
+
+// Prerelease contains prerelease channel settings
+type Prerelease struct {
+	// Channels is the ordered list of channel identifiers, least stable first
+	Channels version.Channels `yaml:"channels"`
+	// Compat reads legacy prereleases like rc1 or beta-2 as rc.1 and beta.2
+	Compat bool `yaml:"compat"`
+}
+
+// Build contains build metadata settings
+type Build struct {
+	// Metadata is appended to new versions as +metadata; it may be a template
+	// such as {{.ShortCommit}}.{{.Date}}
+	Metadata string `yaml:"metadata"`
+	// Policy is "annotation" to record metadata only in the tag annotation and
+	// outputs, or "tag" to also put it in the tag name (default: annotation)
+	Policy string `yaml:"policy"`
+}
+
+// Tag contains settings for the tags created on release
+type Tag struct {
+	// Floating moves tags like v1 and v1.4 to each stable release that is the
+	// latest of its major or minor line
+	Floating bool `yaml:"floating"`
+	// Sign configures signed tags
+	Sign Sign `yaml:"sign"`
+	// Verify configures the trusted keys release tags are checked against
+	Verify Verify `yaml:"verify"`
+	// Message is a text/template for the tag annotation, which can include
+	// the changelog, commit counts and contributors since the last release
+	Message string `yaml:"message"`
+	// Type is the type of new release tags: annotated (default) or lightweight
+	Type string `yaml:"type"`
+	// TypePolicy is how tags of the other type are treated when picking the
+	// latest tag: allow (default), warn or ignore
+	TypePolicy string `yaml:"type-policy"`
+}
+
+// Push configures how release tags and branches are pushed
+type Push struct {
+	// Transport is "git" to run the git binary, which honors the git config,
+	// or "native" to push with go-git where git is not installed (default: git)
+	Transport string `yaml:"transport"`
+	// SSHKey is the private key for SSH remotes with the native transport;
+	// the SSH agent is used when it is empty
+	SSHKey string `yaml:"ssh-key"`
+	// KnownHosts is the known_hosts file SSH host keys are checked against
+	// (default: SSH_KNOWN_HOSTS or ~/.ssh/known_hosts)
+	KnownHosts string `yaml:"known-hosts"`
+	// Remotes are the remotes releases are pushed to, instead of Remote,
+	// such as an internal server and a public mirror
+	Remotes []PushRemote `yaml:"remotes"`
+}
+
+// Fetch configures the tag fetch before the next version is computed
+type Fetch struct {
+	// Disabled skips fetching the remote's tags, which is otherwise done
+	// whenever the remote exists
+	Disabled bool `yaml:"disabled"`
+	// MaxDepth is how many commits a shallow clone is deepened by at most to
+	// reach a release tag (default: 10000)
+	MaxDepth int `yaml:"max-depth"`
+}
+
+// PushRemote is a remote releases are pushed to
+type PushRemote struct {
+	// Name is the git remote
+	Name string `yaml:"name"`
+	// BestEffort reports a failed push as a warning instead of failing the
+	// release, e.g. for mirrors
+	BestEffort bool `yaml:"best-effort"`
+}
+
+// Sign configures tag signatures, verifiable with `git tag -v`
+type Sign struct {
+	// Format is "openpgp" or "ssh", like git's gpg.format; empty disables signing
+	Format string `yaml:"format"`
+	// Key is a keyring or private key file, or inline key material
+	// (default: $BUMPKIN_SIGNING_KEY)
+	Key string `yaml:"key"`
+	// KeyID selects an OpenPGP key by ID or fingerprint (default: first secret key)
+	KeyID string `yaml:"key-id"`
+}
+
+// Verify configures tag signature verification. Releases check the earlier
+// release tags when an allowed signers file or keyring is set.
+type Verify struct {
+	// AllowedSigners is an ssh-keygen style allowed signers file for SSH signatures
+	AllowedSigners string `yaml:"allowed-signers"`
+	// Keyring is an OpenPGP keyring of trusted public keys
+	Keyring string `yaml:"keyring"`
+	// Required aborts releases when earlier release tags are unsigned or
+	// untrusted; otherwise they are reported as warnings
+	Required bool `yaml:"required"`
+}
+
+// VersionFile configures a Go source file that is updated with the new
+// version and committed before tagging
+type VersionFile struct {
+	// Path is the file relative to the repository root; empty disables the update
+	Path string `yaml:"path"`
+	// Var is the string const or var to set (default: Version)
+	Var string `yaml:"var"`
+	// Package is used when the file has to be created (default: main)
+	Package string `yaml:"package"`
+	// Value is a template for the stored value, e.g. {{ pep440 .Version }}
+	// (default: the version)
+	Value string `yaml:"value"`
 }
 
 // Hooks contains pre-tag, post-tag, and post-push hooks
@@ -20,6 +146,11 @@ type Hooks struct {
 	PreTag   []string `yaml:"pre-tag"`
 	PostTag  []string `yaml:"post-tag"`
 	PostPush []string `yaml:"post-push"`
+
+	// Templates expands hook commands as Go templates before they run, e.g.
+	// {{ pep440 .Version }}. Off by default, so commands with {{ for other
+	// tools, like docker inspect --format '{{.Id}}', run as written.
+	Templates bool `yaml:"templates"`
 }
 
 // Default returns a config with default values
@@ -27,7 +158,11 @@ func Default() *Config {
 	return &Config{
 		Prefix: "v",
 		Remote: "origin",
-		Hooks:  Hooks{},
+		Scheme: "semver",
+		Prerelease: Prerelease{
+			Channels: version.DefaultChannels(),
+		},
+		Hooks: Hooks{},
 	}
 }
 
@@ -73,6 +208,71 @@ func LoadFile(path string) (*Config, error) {
 	if cfg.Remote == "" {
 		cfg.Remote = "origin"
 	}
+	if cfg.Scheme == "" {
+		cfg.Scheme = "semver"
+	}
+	if _, err := cfg.VersionScheme(); err != nil {
+		return nil, fmt.Errorf("invalid version scheme config: %w", err)
+	}
+	if len(cfg.Prerelease.Channels) == 0 {
+		cfg.Prerelease.Channels = version.DefaultChannels()
+	}
+	if _, err := version.ParseMetadataPolicy(cfg.Build.Policy); err != nil {
+		return nil, fmt.Errorf("invalid build config: %w", err)
+	}
+	switch cfg.Tag.Sign.Format {
+	case "", "openpgp", "gpg", "ssh":
+	default:
+		return nil, fmt.Errorf(
+			"invalid tag config: unknown signing format %q (use openpgp or ssh)",
+			cfg.Tag.Sign.Format,
+		)
+	}
+	tagType, err := git.ParseTagType(cfg.Tag.Type)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag config: %w", err)
+	}
+	if _, err := git.ParseTagTypePolicy(cfg.Tag.TypePolicy); err != nil {
+		return nil, fmt.Errorf("invalid tag config: %w", err)
+	}
+	switch cfg.Push.Transport {
+	case "", "git", "native":
+	default:
+		return nil, fmt.Errorf(
+			"invalid push config: unknown transport %q (use git or native)",
+			cfg.Push.Transport,
+		)
+	}
+	if cfg.Fetch.MaxDepth < 0 {
+		return nil, fmt.Errorf("invalid fetch config: max-depth must not be negative")
+	}
+	seen := make(map[string]bool)
+	for _, remote := range cfg.Push.Remotes {
+		if remote.Name == "" {
+			return nil, fmt.Errorf("invalid push config: remote without a name")
+		}
+		if seen[remote.Name] {
+			return nil, fmt.Errorf("invalid push config: remote %q listed twice", remote.Name)
+		}
+		seen[remote.Name] = true
+	}
+	if tagType == git.TagLightweight && cfg.Tag.Sign.Format != "" {
+		return nil, fmt.Errorf("invalid tag config: lightweight tags cannot be signed")
+	}
+	if tagType == git.TagLightweight && cfg.Tag.Message != "" {
+		return nil, fmt.Errorf("invalid tag config: lightweight tags have no message")
+	}
+	if err := cfg.Prerelease.Channels.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid prerelease config: %w", err)
+	}
+	if cfg.VersionFile.Path != "" {
+		if cfg.VersionFile.Var == "" {
+			cfg.VersionFile.Var = "Version"
+		}
+		if cfg.VersionFile.Package == "" {
+			cfg.VersionFile.Package = "main"
+		}
+	}
 
 	return cfg, nil
 }
@@ -80,9 +280,17 @@ func LoadFile(path string) (*Config, error) {
 // Merge merges another config into this one, with the other config taking precedence
 func (c *Config) Merge(other *Config) *Config {
 	result := &Config{
-		Prefix: c.Prefix,
-		Remote: c.Remote,
-		Hooks:  c.Hooks,
+		Prefix:      c.Prefix,
+		Remote:      c.Remote,
+		Scheme:      c.Scheme,
+		CalVer:      c.CalVer,
+		Prerelease:  c.Prerelease,
+		Build:       c.Build,
+		Tag:         c.Tag,
+		Push:        c.Push,
+		Fetch:       c.Fetch,
+		VersionFile: c.VersionFile,
+		Hooks:       c.Hooks,
 	}
 
 	if other.Prefix != "" {
@@ -91,6 +299,63 @@ func (c *Config) Merge(other *Config) *Config {
 	if other.Remote != "" {
 		result.Remote = other.Remote
 	}
+	if other.Scheme != "" {
+		result.Scheme = other.Scheme
+	}
+	if other.CalVer.Format != "" {
+		result.CalVer = other.CalVer
+	}
+	if len(other.Prerelease.Channels) > 0 {
+		result.Prerelease.Channels = other.Prerelease.Channels
+	}
+	if other.Prerelease.Compat {
+		result.Prerelease.Compat = true
+	}
+	if other.Build.Metadata != "" {
+		result.Build.Metadata = other.Build.Metadata
+	}
+	if other.Build.Policy != "" {
+		result.Build.Policy = other.Build.Policy
+	}
+	if other.Tag.Floating {
+		result.Tag.Floating = true
+	}
+	if other.Tag.Sign.Format != "" {
+		result.Tag.Sign = other.Tag.Sign
+	}
+	if other.Tag.Verify.AllowedSigners != "" || other.Tag.Verify.Keyring != "" {
+		result.Tag.Verify.AllowedSigners = other.Tag.Verify.AllowedSigners
+		result.Tag.Verify.Keyring = other.Tag.Verify.Keyring
+	}
+	if other.Tag.Verify.Required {
+		result.Tag.Verify.Required = true
+	}
+	if other.Tag.Message != "" {
+		result.Tag.Message = other.Tag.Message
+	}
+	if other.Tag.Type != "" {
+		result.Tag.Type = other.Tag.Type
+	}
+	if other.Tag.TypePolicy != "" {
+		result.Tag.TypePolicy = other.Tag.TypePolicy
+	}
+	if other.Push.Transport != "" {
+		result.Push.Transport = other.Push.Transport
+		result.Push.SSHKey = other.Push.SSHKey
+		result.Push.KnownHosts = other.Push.KnownHosts
+	}
+	if len(other.Push.Remotes) > 0 {
+		result.Push.Remotes = other.Push.Remotes
+	}
+	if other.Fetch.Disabled {
+		result.Fetch.Disabled = true
+	}
+	if other.Fetch.MaxDepth != 0 {
+		result.Fetch.MaxDepth = other.Fetch.MaxDepth
+	}
+	if other.VersionFile.Path != "" {
+		result.VersionFile = other.VersionFile
+	}
 	if len(other.Hooks.PreTag) > 0 {
 		result.Hooks.PreTag = other.Hooks.PreTag
 	}
@@ -100,6 +365,14 @@ func (c *Config) Merge(other *Config) *Config {
 	if len(other.Hooks.PostPush) > 0 {
 		result.Hooks.PostPush = other.Hooks.PostPush
 	}
+	if other.Hooks.Templates {
+		result.Hooks.Templates = true
+	}
 
 	return result
 }
+
+// VersionScheme returns the configured version scheme
+func (c *Config) VersionScheme() (version.Scheme, error) {
+	return version.NewScheme(c.Scheme, c.CalVer.Format)
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/benny123tw/bumpkin/internal/version"
 )
 
 // T085: Test for loading .bumpkin.yaml
@@ -223,3 +225,374 @@ func TestConfig_MergeWithPostPush(t *testing.T) {
 	assert.Len(t, merged.Hooks.PostPush, 1)
 	assert.Equal(t, "echo override", merged.Hooks.PostPush[0])
 }
+
+func TestLoad_PrereleaseChannels(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+prerelease:
+  channels: [dev, canary, next, preview]
+  compat: true
+`
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+	//nolint:gosec // test file
+	err := os.WriteFile(configPath, []byte(configContent), 0o644)
+	require.NoError(t, err)
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, version.Channels{"dev", "canary", "next", "preview"}, cfg.Prerelease.Channels)
+	assert.True(t, cfg.Prerelease.Compat)
+}
+
+func TestLoad_DefaultPrereleaseChannels(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, version.DefaultChannels(), cfg.Prerelease.Channels)
+}
+
+func TestLoad_InvalidPrereleaseChannels(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+prerelease:
+  channels: [beta, beta]
+`
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+	//nolint:gosec // test file
+	err := os.WriteFile(configPath, []byte(configContent), 0o644)
+	require.NoError(t, err)
+
+	_, err = Load(tmpDir)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate prerelease channel")
+}
+
+func TestLoad_VersionFileDefaults(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+version-file:
+  path: internal/build/version.go
+`
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+	//nolint:gosec // test file
+	err := os.WriteFile(configPath, []byte(configContent), 0o644)
+	require.NoError(t, err)
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "internal/build/version.go", cfg.VersionFile.Path)
+	assert.Equal(t, "Version", cfg.VersionFile.Var)
+	assert.Equal(t, "main", cfg.VersionFile.Package)
+}
+
+func TestLoad_CalVerScheme(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+scheme: calver
+calver:
+  format: YY.0M.MICRO
+`
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+	//nolint:gosec // test file
+	err := os.WriteFile(configPath, []byte(configContent), 0o644)
+	require.NoError(t, err)
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+
+	scheme, err := cfg.VersionScheme()
+	require.NoError(t, err)
+	assert.Equal(t, "calver", scheme.Name())
+
+	v, err := scheme.Parse("26.10.3")
+	require.NoError(t, err)
+	assert.Equal(t, "26.10.3", scheme.Format(v))
+}
+
+func TestLoad_InvalidScheme(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+scheme: calver
+calver:
+  format: YYYY.MICRO.MM
+`
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+	//nolint:gosec // test file
+	err := os.WriteFile(configPath, []byte(configContent), 0o644)
+	require.NoError(t, err)
+
+	_, err = Load(tmpDir)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "MICRO must be the last segment")
+}
+
+func TestLoad_Build(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+build:
+  metadata: "{{.ShortCommit}}.{{.Date}}"
+  policy: tag
+`
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+	//nolint:gosec // test file
+	err := os.WriteFile(configPath, []byte(configContent), 0o644)
+	require.NoError(t, err)
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "{{.ShortCommit}}.{{.Date}}", cfg.Build.Metadata)
+	assert.Equal(t, "tag", cfg.Build.Policy)
+}
+
+func TestLoad_InvalidBuildPolicy(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+build:
+  policy: name
+`
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+	//nolint:gosec // test file
+	err := os.WriteFile(configPath, []byte(configContent), 0o644)
+	require.NoError(t, err)
+
+	_, err = Load(tmpDir)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown metadata policy")
+}
+
+func TestLoad_TagFloating(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+tag:
+  floating: true
+`
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+	//nolint:gosec // test file
+	err := os.WriteFile(configPath, []byte(configContent), 0o644)
+	require.NoError(t, err)
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.Tag.Floating)
+	assert.True(t, Default().Merge(cfg).Tag.Floating)
+}
+
+func TestLoad_TagMessage(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+tag:
+  message: |
+    Release {{.Tag}}
+
+    {{.Changelog}}
+`
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+	//nolint:gosec // test file
+	err := os.WriteFile(configPath, []byte(configContent), 0o644)
+	require.NoError(t, err)
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Release {{.Tag}}\n\n{{.Changelog}}\n", cfg.Tag.Message)
+	assert.Equal(t, cfg.Tag.Message, Default().Merge(cfg).Tag.Message)
+}
+
+func TestLoad_TagType(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+
+	configContent := `
+tag:
+  type: lightweight
+  type-policy: ignore
+`
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0o644))
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, "lightweight", cfg.Tag.Type)
+	assert.Equal(t, "ignore", cfg.Tag.TypePolicy)
+	merged := Default().Merge(cfg)
+	assert.Equal(t, "lightweight", merged.Tag.Type)
+	assert.Equal(t, "ignore", merged.Tag.TypePolicy)
+
+	tests := map[string]string{
+		"tag:\n  type: signed\n":                                "unknown tag type",
+		"tag:\n  type-policy: strict\n":                         "unknown tag type policy",
+		"tag:\n  type: lightweight\n  sign:\n    format: ssh\n": "cannot be signed",
+		"tag:\n  type: lightweight\n  message: Release\n":       "have no message",
+	}
+	for content, want := range tests {
+		//nolint:gosec // test file
+		require.NoError(t, os.WriteFile(configPath, []byte(content), 0o644))
+		_, err := Load(tmpDir)
+		assert.ErrorContains(t, err, want)
+	}
+}
+
+func TestLoad_Push(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+
+	configContent := `
+push:
+  transport: native
+  ssh-key: ~/.ssh/release_ed25519
+  known-hosts: /etc/bumpkin/known_hosts
+`
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0o644))
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+	want := Push{
+		Transport:  "native",
+		SSHKey:     "~/.ssh/release_ed25519",
+		KnownHosts: "/etc/bumpkin/known_hosts",
+	}
+	assert.Equal(t, want, cfg.Push)
+	assert.Equal(t, want, Default().Merge(cfg).Push)
+
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(configPath, []byte("push:\n  transport: rsync\n"), 0o644))
+	_, err = Load(tmpDir)
+	assert.ErrorContains(t, err, "unknown transport")
+}
+
+func TestLoad_PushRemotes(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+
+	configContent := `
+push:
+  remotes:
+    - name: gitea
+    - name: github
+      best-effort: true
+`
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0o644))
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+	want := []PushRemote{{Name: "gitea"}, {Name: "github", BestEffort: true}}
+	assert.Equal(t, want, cfg.Push.Remotes)
+	assert.Equal(t, want, Default().Merge(cfg).Push.Remotes)
+
+	configContent = "push:\n  remotes:\n    - name: gitea\n    - name: gitea\n"
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0o644))
+	_, err = Load(tmpDir)
+	assert.ErrorContains(t, err, "listed twice")
+}
+
+func TestLoad_Fetch(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(configPath, []byte("fetch:\n  max-depth: 500\n"), 0o644))
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, Fetch{MaxDepth: 500}, cfg.Fetch)
+	assert.Equal(t, Fetch{MaxDepth: 500}, Default().Merge(cfg).Fetch)
+
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(configPath, []byte("fetch:\n  max-depth: -1\n"), 0o644))
+	_, err = Load(tmpDir)
+	assert.ErrorContains(t, err, "invalid fetch config")
+}
+
+func TestLoad_HookTemplates(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+	assert.False(t, cfg.Hooks.Templates, "templates are opt-in")
+
+	configContent := "hooks:\n  templates: true\n  pre-tag:\n    - echo {{ pep440 .Version }}\n"
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0o644))
+	cfg, err = Load(tmpDir)
+	require.NoError(t, err)
+	assert.True(t, cfg.Hooks.Templates)
+	assert.True(t, Default().Merge(cfg).Hooks.Templates)
+}
+
+func TestLoad_TagSign(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+tag:
+  sign:
+    format: ssh
+    key: ~/.ssh/id_ed25519
+`
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+	//nolint:gosec // test file
+	err := os.WriteFile(configPath, []byte(configContent), 0o644)
+	require.NoError(t, err)
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, "ssh", cfg.Tag.Sign.Format)
+	assert.Equal(t, "~/.ssh/id_ed25519", cfg.Tag.Sign.Key)
+
+	//nolint:gosec // test file
+	err = os.WriteFile(configPath, []byte("tag:\n  sign:\n    format: x509\n"), 0o644)
+	require.NoError(t, err)
+	_, err = Load(tmpDir)
+	assert.ErrorContains(t, err, "unknown signing format")
+}
+
+func TestLoad_TagVerify(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+tag:
+  verify:
+    allowed-signers: .allowed_signers
+    required: true
+`
+	configPath := filepath.Join(tmpDir, ".bumpkin.yaml")
+	//nolint:gosec // test file
+	err := os.WriteFile(configPath, []byte(configContent), 0o644)
+	require.NoError(t, err)
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, ".allowed_signers", cfg.Tag.Verify.AllowedSigners)
+	assert.True(t, cfg.Tag.Verify.Required)
+	assert.Equal(t, cfg.Tag.Verify, Default().Merge(cfg).Tag.Verify)
+}
+
+func TestConfig_MergeVerifyRequired(t *testing.T) {
+	base := Default()
+	base.Tag.Verify.Keyring = "keys.gpg"
+
+	// Requiring signatures does not need the trusted keys repeated
+	other := &Config{}
+	other.Tag.Verify.Required = true
+
+	merged := base.Merge(other)
+	assert.Equal(t, "keys.gpg", merged.Tag.Verify.Keyring)
+	assert.True(t, merged.Tag.Verify.Required)
+}
@@ -62,6 +62,14 @@ func AnalyzeCommits(messages []string) *AnalysisResult {
 	return result
 }
 
+// RecommendedPrerelease returns the bump that puts the recommended change
+// level onto a prerelease of the current version. It continues the current
+// prerelease when that already covers the change, and otherwise starts a
+// pre-level bump (e.g., feat commits on 1.2.3 give BumpPreminor).
+func (r *AnalysisResult) RecommendedPrerelease(current version.Version) version.BumpType {
+	return version.PrereleaseBumpFor(current, r.RecommendedBump)
+}
+
 // AnalyzeCommitMessages is a convenience function that takes git.Commit objects
 // This allows integration with the git package
 func AnalyzeCommitMessages(subjects []string) *AnalysisResult {
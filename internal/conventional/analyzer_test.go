@@ -146,3 +146,13 @@ func TestAnalyzeCommits_DocsRecommendsPatch(t *testing.T) {
 	result := AnalyzeCommits(commits)
 	assert.Equal(t, version.BumpPatch, result.RecommendedBump)
 }
+
+func TestAnalysisResult_RecommendedPrerelease(t *testing.T) {
+	result := AnalyzeCommits([]string{"feat: add login"})
+
+	stable := version.Version{Major: 1, Minor: 2, Patch: 3}
+	assert.Equal(t, version.BumpPreminor, result.RecommendedPrerelease(stable))
+
+	onMinorLine := version.Version{Major: 1, Minor: 3, Prerelease: "beta.0"}
+	assert.Equal(t, version.BumpPrereleaseChannel, result.RecommendedPrerelease(onMinorLine))
+}
@@ -2,7 +2,11 @@ package executor
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
 
 	"github.com/benny123tw/bumpkin/internal/git"
 	"github.com/benny123tw/bumpkin/internal/hooks"
@@ -11,17 +15,39 @@ import (
 
 // Request contains the parameters for a version bump operation
 type Request struct {
-	Repository    *git.Repository
-	BumpType      version.BumpType
-	CustomVersion string // Only used when BumpType is BumpCustom
-	Prefix        string // Tag prefix (default: "v")
-	Remote        string // Remote name (default: "origin")
-	DryRun        bool   // If true, don't actually create/push tags
-	NoPush        bool   // If true, create tag but don't push
-	NoHooks       bool   // If true, skip hook execution
-	PreTagHooks   []string
-	PostTagHooks  []string
-	PostPushHooks []string // Hooks to run after successful push (fail-open)
+	Repository       *git.Repository
+	BumpType         version.BumpType
+	CustomVersion    string         // Only used when BumpType is BumpCustom
+	Scheme           version.Scheme // Version scheme (default: the repository's scheme)
+	Channel          string         // Prerelease channel for BumpPrereleaseChannel and pre-level bumps
+	Channels         version.Channels
+	Force            bool   // If true, allow channel regressions, downgrades and duplicates
+	ForceReason      string // Why the request is forced, recorded in the annotation
+	PrereleaseCompat bool   // If true, read legacy prereleases like rc1 as rc.1
+	Promote          bool   // If true, release a prerelease tag at its own commit
+	PromoteFrom      string // Prerelease tag to promote (default: latest tag)
+	At               string // Revision to tag (default: HEAD)
+	VersionFile      VersionFile
+	Prefix           string // Tag prefix (default: "v")
+	Remote           string // Remote name (default: "origin")
+	DryRun           bool   // If true, don't actually create/push tags
+	NoPush           bool   // If true, create tag but don't push
+	NoHooks          bool   // If true, skip hook execution
+	PreTagHooks      []string
+	PostTagHooks     []string
+	PostPushHooks    []string               // Hooks to run after successful push (fail-open)
+	HookTemplates    bool                   // If true, hook commands are expanded as Go templates
+	BuildMetadata    string                 // Build metadata or template, e.g. {{.ShortCommit}}
+	MetadataPolicy   version.MetadataPolicy // Where build metadata goes (default: annotation)
+	Floating         bool                   // Move floating tags like v1 and v1.4 to stable releases
+	Message          string                 // Tag message template (default: "Release <tag>")
+	Verifier         git.Verifier           // If set, verify the signatures of earlier release tags
+	RequireSigned    bool                   // If true, unverified release tags abort the release
+	// Branch pushed atomically with the tag (default: the current branch if
+	// the version file was committed, otherwise none)
+	PushBranch string
+	// Remotes the release is pushed to (default: Remote, required)
+	Remotes []PushRemote
 }
 
 // Result contains the outcome of a version bump operation
@@ -30,10 +56,18 @@ type Result struct {
 	NewVersion       string
 	TagName          string
 	CommitHash       string
+	PromotedFrom     string // Prerelease tag whose commit was released, if promoting
+	VersionCommitted bool   // True if the version file was updated in a release commit
 	TagCreated       bool
 	Pushed           bool
 	HooksExecuted    int
 	PostPushWarnings []string // Warnings from failed post-push hooks (fail-open)
+	Warnings         []string // Version warnings, such as gaps after the previous version
+	Forced           []string // Version checks overridden by Force
+	FloatingTags     []string // Floating tags moved to the release, like v1 and v1.4
+
+	Remotes      []RemoteResult // Outcome of the push to each remote
+	PushWarnings []string       // Best-effort remotes that were not pushed
 }
 
 // Execute performs a version bump operation
@@ -46,12 +80,58 @@ func Execute(ctx context.Context, req Request) (*Result, error) {
 		req.Remote = "origin"
 	}
 
-	// Get the latest tag
-	latestTag, err := req.Repository.LatestTag(req.Prefix)
+	if req.Promote && req.At != "" {
+		return nil, fmt.Errorf("cannot promote a prerelease at another revision")
+	}
+	updatesVersionFile := req.VersionFile.Path != "" && !req.Promote && req.At == ""
+	if updatesVersionFile {
+		if err := CheckVersionFile(req.Repository, req.VersionFile); err != nil {
+			return nil, err
+		}
+	}
+	scheme := req.scheme()
+
+	// Get the latest tag, reachable from the target revision if one is given
+	var atHash plumbing.Hash
+	var latestTag *git.Tag
+	var err error
+	if req.At != "" {
+		atHash, err = req.Repository.ResolveRevision(req.At)
+		if err != nil {
+			return nil, err
+		}
+		latestTag, err = req.Repository.LatestTagAt(req.Prefix, atHash)
+	} else {
+		latestTag, err = req.Repository.LatestTag(req.Prefix)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get latest tag: %w", err)
 	}
 
+	// A latest tag of the other tag type is only used with a warning
+	var warnings []string
+	if w := req.Repository.TagTypeWarning(latestTag); w != "" {
+		warnings = append(warnings, w)
+	}
+
+	// Check that the release tags so far are signed by trusted keys
+	if req.Verifier != nil {
+		chainHash := atHash
+		if req.At == "" {
+			chainHash, err = req.Repository.GetHEAD()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get HEAD: %w", err)
+			}
+		}
+		unverified, err := CheckChain(
+			req.Repository, req.Prefix, chainHash, req.Verifier, req.RequireSigned,
+		)
+		if err != nil {
+			return nil, err
+		}
+		warnings = append(warnings, unverified...)
+	}
+
 	// Determine previous version
 	var prevVersion version.Version
 	if latestTag == nil || latestTag.Version == nil {
@@ -60,53 +140,137 @@ func Execute(ctx context.Context, req Request) (*Result, error) {
 		prevVersion = *latestTag.Version
 	}
 
-	// Calculate new version
 	var newVersion version.Version
-	switch req.BumpType {
-	case version.BumpCustom:
-		if req.CustomVersion == "" {
-			return nil, fmt.Errorf("custom version not specified")
+	var targetHash plumbing.Hash
+	var promoteSource *git.Tag
+
+	if req.Promote {
+		// Release the prerelease at the exact commit it was tagged on
+		source, err := PromotionSource(req.Repository, req.Prefix, req.PromoteFrom)
+		if err != nil {
+			return nil, err
 		}
-		parsed, err := version.Parse(req.CustomVersion)
+		prevVersion = *source.Version
+		newVersion = version.BumpToRelease(prevVersion)
+		targetHash = plumbing.NewHash(source.CommitHash)
+		promoteSource = source
+	} else {
+		// Calculate new version
+		newVersion, err = NextVersion(req, prevVersion)
 		if err != nil {
-			return nil, fmt.Errorf("invalid custom version: %w", err)
+			return nil, err
+		}
+
+		// Tag the requested revision, or HEAD
+		if req.At != "" {
+			targetHash = atHash
+		} else {
+			targetHash, err = req.Repository.GetHEAD()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get HEAD: %w", err)
+			}
 		}
-		newVersion = parsed
-	case version.BumpPatch, version.BumpMinor, version.BumpMajor, version.BumpRelease,
-		version.BumpPrereleaseAlpha, version.BumpPrereleaseBeta, version.BumpPrereleaseRC:
-		newVersion = version.Bump(prevVersion, req.BumpType)
-	default:
-		return nil, fmt.Errorf("unsupported bump type: %s", req.BumpType)
 	}
 
-	// Build tag name
-	tagName := newVersion.StringWithPrefix(req.Prefix)
+	// Attach build metadata to the new version
+	if req.BuildMetadata != "" {
+		metadata, err := RenderMetadata(req.Repository, req.BuildMetadata, targetHash)
+		if err != nil {
+			return nil, err
+		}
+		newVersion = newVersion.WithMetadata(metadata)
+	}
 
-	// Get HEAD commit hash
-	headHash, err := req.Repository.GetHEAD()
+	// Build tag name; unless the policy puts it in the tag name, build
+	// metadata is only recorded in the annotation and outputs
+	tagVersion := newVersion
+	if req.MetadataPolicy != version.MetadataInTag {
+		tagVersion = tagVersion.WithMetadata("")
+	}
+	tagName := version.FormatWithPrefix(scheme, tagVersion, req.Prefix)
+	tagMessage, err := TagMessage(
+		req.Repository, req.Message, req.Prefix, tagName, newVersion, targetHash,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get HEAD: %w", err)
+		return nil, err
+	}
+	var promotedFrom string
+	if promoteSource != nil {
+		if req.Message == "" {
+			tagMessage = PromotionMessage(tagName, promoteSource)
+		} else {
+			tagMessage += "\n\nPromoted from " + promoteSource.Name
+		}
+		promotedFrom = promoteSource.Name
+	}
+	annotationMetadata := ""
+	if tagVersion.Metadata == "" {
+		annotationMetadata = newVersion.Metadata
+		tagMessage = MetadataMessage(tagMessage, annotationMetadata)
+	}
+
+	// The new version must follow the latest tag and must not be tagged yet
+	baseline := latestTag
+	if req.PrereleaseCompat && latestTag != nil && latestTag.Version != nil {
+		normalized := *latestTag.Version
+		normalized.Prerelease = version.NormalizePrerelease(normalized.Prerelease)
+		baseline = &git.Tag{Name: latestTag.Name, Version: &normalized}
+	}
+	check, err := CheckVersion(req.Repository, scheme, req.Prefix, baseline, newVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check version: %w", err)
+	}
+	var forced []string
+	if len(check.Violations) > 0 {
+		if !req.Force {
+			return nil, check.Err()
+		}
+		for _, v := range check.Violations {
+			forced = append(forced, v.Error())
+		}
+		tagMessage = ForceMessage(tagMessage, check.Violations, req.ForceReason)
+	}
+	forceReason := ""
+	if len(forced) > 0 {
+		forceReason = req.ForceReason
+	}
+	if w := LightweightWarning(
+		req.Repository, req.Message, forceReason, annotationMetadata,
+	); w != "" {
+		warnings = append(warnings, w)
 	}
 
 	result := &Result{
-		PreviousVersion: prevVersion.String(),
-		NewVersion:      newVersion.String(),
+		PreviousVersion: scheme.Format(prevVersion),
+		NewVersion:      scheme.Format(newVersion),
 		TagName:         tagName,
-		CommitHash:      headHash.String(),
+		CommitHash:      targetHash.String(),
+		PromotedFrom:    promotedFrom,
 		TagCreated:      false,
 		Pushed:          false,
 		HooksExecuted:   0,
+		Warnings:        append(warnings, check.Warnings...),
+		Forced:          forced,
+	}
+
+	// Floating tags only follow stable releases of schemes with minor versions
+	if req.Floating && scheme.Supports(version.BumpMinor) {
+		result.FloatingTags, err = FloatingTags(req.Repository, req.Prefix, newVersion)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Prepare hook context
 	hookCtx := &hooks.HookContext{
-		Version:         newVersion.String(),
-		PreviousVersion: prevVersion.String(),
+		Version:         scheme.Format(newVersion),
+		PreviousVersion: scheme.Format(prevVersion),
 		TagName:         tagName,
 		Prefix:          req.Prefix,
 		Remote:          req.Remote,
-		CommitHash:      headHash.String(),
+		CommitHash:      targetHash.String(),
 		DryRun:          req.DryRun,
+		Templates:       req.HookTemplates,
 	}
 
 	// Dry run - don't actually do anything
@@ -114,6 +278,16 @@ func Execute(ctx context.Context, req Request) (*Result, error) {
 		return result, nil
 	}
 
+	// Check the remotes for the tag before anything is created, so a version
+	// another release already took fails without leaving local changes
+	var remotes []PushRemote
+	if !req.NoPush {
+		remotes, result.PushWarnings, err = req.pushRemotes(ctx, tagName)
+		if err != nil {
+			return result, err
+		}
+	}
+
 	// Run pre-tag hooks
 	if !req.NoHooks && len(req.PreTagHooks) > 0 {
 		preHooks := hooks.CreateHooks(req.PreTagHooks, hooks.PreTag)
@@ -124,26 +298,49 @@ func Execute(ctx context.Context, req Request) (*Result, error) {
 		result.HooksExecuted += len(results)
 	}
 
-	// Create the tag
-	tagMessage := fmt.Sprintf("Release %s", tagName)
-	if err := req.Repository.CreateTag(tagName, tagMessage); err != nil {
-		return nil, fmt.Errorf("failed to create tag: %w", err)
+	// Commit the version file so the tagged source matches the tag. Promotions
+	// and --at tag existing commits, so they leave the file alone.
+	versionFile := req.VersionFile
+	if !updatesVersionFile {
+		versionFile = VersionFile{}
+	}
+	targetHash, result.VersionCommitted, err = TagRelease(
+		ctx, req.Repository, versionFile, scheme.Format(newVersion), tagName, tagMessage, targetHash,
+	)
+	if err != nil {
+		return result, err
 	}
+	result.CommitHash = targetHash.String()
+	hookCtx.CommitHash = targetHash.String()
 	result.TagCreated = true
 
-	// Push if requested
-	if !req.NoPush {
-		// Check if remote exists before pushing
-		hasRemote, err := req.Repository.HasRemote(req.Remote)
-		if err != nil {
-			return result, fmt.Errorf("failed to check remote: %w", err)
+	// Move the floating tags to the release
+	for _, name := range result.FloatingTags {
+		if err := req.Repository.MoveTag(name, targetHash); err != nil {
+			return result, fmt.Errorf("failed to move floating tag: %w", err)
 		}
+	}
 
-		if hasRemote {
-			if err := req.Repository.PushTag(ctx, tagName, req.Remote); err != nil {
-				return result, fmt.Errorf("failed to push tag: %w", err)
+	// Push the release commit and the tag together, so a remote never has
+	// one without the other
+	if len(remotes) > 0 {
+		branch := req.PushBranch
+		if branch == "" && result.VersionCommitted {
+			branch, err = req.Repository.GetCurrentBranch()
+			if err != nil {
+				return result, fmt.Errorf("failed to push release: %w", err)
 			}
-			result.Pushed = true
+		}
+		results, warnings, err := PushRemotes(
+			ctx, req.Repository, remotes, branch, tagName, result.FloatingTags,
+		)
+		result.Remotes = results
+		result.PushWarnings = append(result.PushWarnings, warnings...)
+		if err != nil {
+			return result, err
+		}
+		for _, r := range results {
+			result.Pushed = result.Pushed || r.Pushed
 		}
 	}
 
@@ -173,3 +370,141 @@ func Execute(ctx context.Context, req Request) (*Result, error) {
 
 	return result, nil
 }
+
+// NextVersion calculates the version that the request would create on top of prev.
+// Prerelease bumps are validated against the request's channels.
+func NextVersion(req Request, prev version.Version) (version.Version, error) {
+	req.Channels = req.channels()
+	if req.PrereleaseCompat && prev.IsPrerelease() {
+		prev.Prerelease = version.NormalizePrerelease(prev.Prerelease)
+	}
+
+	scheme := req.scheme()
+	if !scheme.Supports(req.BumpType) {
+		return version.Version{}, fmt.Errorf(
+			"%w: %s under %s", version.ErrUnsupportedBump, req.BumpType, scheme.Name(),
+		)
+	}
+
+	switch req.BumpType {
+	case version.BumpCustom:
+		if req.CustomVersion == "" {
+			return version.Version{}, fmt.Errorf("custom version not specified")
+		}
+		parsed, err := scheme.Parse(req.CustomVersion)
+		if err != nil {
+			return version.Version{}, fmt.Errorf("invalid custom version: %w", err)
+		}
+		return parsed, nil
+	case version.BumpPatch, version.BumpMinor, version.BumpMajor, version.BumpRelease:
+		return scheme.Bump(prev, req.BumpType)
+	case version.BumpPrereleaseAlpha, version.BumpPrereleaseBeta, version.BumpPrereleaseRC,
+		version.BumpPrereleaseChannel:
+		channel := req.BumpType.Channel()
+		if req.BumpType == version.BumpPrereleaseChannel {
+			channel = req.Channel
+		}
+		if channel == "" {
+			return version.Version{}, fmt.Errorf("prerelease channel not specified")
+		}
+		if err := checkChannelBump(req, prev, channel); err != nil {
+			return version.Version{}, err
+		}
+		return version.NextPrerelease(prev, channel, false)
+	case version.BumpPremajor, version.BumpPreminor, version.BumpPrepatch:
+		// A new version line starts fresh, so only the channel name is checked
+		channel := req.Channel
+		if channel == "" {
+			channel = req.Channels[0]
+		}
+		if err := req.Channels.Check(channel); err != nil {
+			return version.Version{}, err
+		}
+		return version.BumpPreLevel(prev, req.BumpType, channel), nil
+	default:
+		return version.Version{}, fmt.Errorf("unsupported bump type: %s", req.BumpType)
+	}
+}
+
+// scheme returns the request's version scheme, defaulting to the scheme the
+// repository parses tags with
+func (req Request) scheme() version.Scheme {
+	switch {
+	case req.Scheme != nil:
+		return req.Scheme
+	case req.Repository != nil:
+		return req.Repository.Scheme()
+	default:
+		return version.DefaultScheme()
+	}
+}
+
+// channels returns the request's prerelease channels, defaulting to the
+// channels the repository orders prereleases by
+func (req Request) channels() version.Channels {
+	switch {
+	case len(req.Channels) > 0:
+		return req.Channels
+	case req.Repository != nil:
+		return req.Repository.Channels()
+	default:
+		return version.DefaultChannels()
+	}
+}
+
+// PromotionSource resolves the prerelease tag to promote. With an empty name
+// it uses the latest tag; a name without the prefix is also accepted.
+func PromotionSource(repo *git.Repository, prefix, name string) (*git.Tag, error) {
+	var source *git.Tag
+	var err error
+
+	if name == "" {
+		source, err = repo.LatestTag(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest tag: %w", err)
+		}
+		if source == nil {
+			return nil, fmt.Errorf("no version tags to promote")
+		}
+	} else {
+		source, err = findTag(repo, prefix, name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if source.Version == nil || !source.Version.IsPrerelease() {
+		return nil, fmt.Errorf("tag %q is not a prerelease", source.Name)
+	}
+
+	return source, nil
+}
+
+// findTag looks up a tag by name, also trying the name with the prefix added
+func findTag(repo *git.Repository, prefix, name string) (*git.Tag, error) {
+	tag, err := repo.FindTag(name)
+	if err != nil && !strings.HasPrefix(name, prefix) {
+		tag, err = repo.FindTag(prefix + name)
+	}
+	return tag, err
+}
+
+// PromotionMessage builds the annotation for a promoted release, carrying over
+// the annotation of the prerelease tag
+func PromotionMessage(tagName string, source *git.Tag) string {
+	message := fmt.Sprintf("Release %s\n\nPromoted from %s", tagName, source.Name)
+	if source.IsAnnotated && source.Message != "" {
+		message += "\n\n" + source.Message
+	}
+	return message
+}
+
+// checkChannelBump validates a prerelease bump against the configured channels.
+// Regressions to an earlier channel are allowed when the request is forced.
+func checkChannelBump(req Request, prev version.Version, channel string) error {
+	err := req.Channels.CheckChannelBump(prev, channel)
+	if req.Force && errors.Is(err, version.ErrChannelRegression) {
+		return nil
+	}
+	return err
+}
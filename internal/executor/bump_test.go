@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -240,6 +241,43 @@ func getCurrentBranch(t *testing.T, dir string) string {
 }
 
 // T012: Test post-push hooks execute after successful push
+func TestExecute_RemoteTagExists(t *testing.T) {
+	remoteDir := t.TempDir()
+	runGit(t, remoteDir, "init", "--bare")
+
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "remote", "add", "origin", remoteDir)
+
+	branch := getCurrentBranch(t, tmpDir)
+	runGit(t, tmpDir, "push", "-u", "origin", branch)
+
+	createTag(t, tmpDir)
+	runGit(t, tmpDir, "push", "origin", "v1.0.0")
+	createCommit(t, tmpDir, "feat: new feature")
+
+	// Another release already took v1.1.0
+	runGit(t, tmpDir, "push", "origin", "v1.0.0^{commit}:refs/tags/v1.1.0")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	markerFile := filepath.Join(t.TempDir(), "pre-tag")
+	_, err = Execute(context.Background(), Request{
+		Repository:  repo,
+		BumpType:    version.BumpMinor,
+		Prefix:      "v",
+		Remote:      "origin",
+		PreTagHooks: []string{"touch " + markerFile},
+	})
+	require.ErrorIs(t, err, git.ErrRemoteTagExists)
+
+	// The check runs before hooks and before the tag is created
+	assert.NoFileExists(t, markerFile)
+	_, err = repo.FindTag("v1.1.0")
+	assert.Error(t, err)
+}
+
 func TestExecute_PostPushHooksAfterPush(t *testing.T) {
 	// Create remote
 	remoteDir := t.TempDir()
@@ -385,3 +423,555 @@ func TestExecute_PostPushHookFailureIsWarning(t *testing.T) {
 	assert.Len(t, result.PostPushWarnings, 1)
 	assert.Contains(t, result.PostPushWarnings[0], "exit 1")
 }
+
+func TestExecute_PrereleaseChannel(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "feat: new feature")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpPrereleaseChannel,
+		Channel:    "canary",
+		Channels:   version.Channels{"dev", "canary", "next"},
+		Prefix:     "v",
+		NoPush:     true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.1-canary.0", result.NewVersion)
+	assert.True(t, result.TagCreated)
+}
+
+func TestExecute_PrereleaseChannelOrder(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+	// Not alphabetical: canary < dev and next < preview would sort differently
+	repo.SetChannels(version.Channels{"dev", "canary", "next", "preview"})
+
+	for _, want := range []string{
+		"1.0.1-dev.0", "1.0.1-canary.0", "1.0.1-canary.1", "1.0.1-next.0", "1.0.1-preview.0",
+	} {
+		createCommit(t, tmpDir, "fix: step to "+want)
+		channel := strings.SplitN(strings.TrimPrefix(want, "1.0.1-"), ".", 2)[0]
+
+		result, err := Execute(context.Background(), Request{
+			Repository: repo,
+			BumpType:   version.BumpPrereleaseChannel,
+			Channel:    channel,
+			Prefix:     "v",
+			NoPush:     true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, want, result.NewVersion)
+
+		latest, err := repo.LatestTag("v")
+		require.NoError(t, err)
+		assert.Equal(t, "v"+want, latest.Name)
+	}
+
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpRelease,
+		Prefix:     "v",
+		NoPush:     true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.1", result.NewVersion)
+	assert.Empty(t, result.Forced)
+}
+
+func TestExecute_PrereleaseChannelUnknown(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	_, err = Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpPrereleaseAlpha,
+		Channels:   version.Channels{"dev", "canary"},
+		Prefix:     "v",
+		DryRun:     true,
+	})
+
+	assert.ErrorIs(t, err, version.ErrUnknownChannel)
+}
+
+func TestExecute_PrereleaseChannelRegression(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.1.0-rc.1", "-m", "Release 1.1.0-rc.1")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	req := Request{
+		Repository: repo,
+		BumpType:   version.BumpPrereleaseAlpha,
+		Prefix:     "v",
+		DryRun:     true,
+	}
+
+	_, err = Execute(context.Background(), req)
+	assert.ErrorIs(t, err, version.ErrChannelRegression)
+
+	req.Force = true
+	result, err := Execute(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "1.1.0-alpha.0", result.NewVersion)
+}
+
+func TestExecute_PreminorBump(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.2.0-rc.1", "-m", "Release 1.2.0-rc.1")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	// Starting a new line on an earlier channel is not a regression
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpPreminor,
+		Channel:    "alpha",
+		Prefix:     "v",
+		DryRun:     true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1.3.0-alpha.0", result.NewVersion)
+}
+
+func TestExecute_LegacyPrerelease(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.4.0-rc1", "-m", "Release 1.4.0-rc1")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	req := Request{
+		Repository: repo,
+		BumpType:   version.BumpPrereleaseRC,
+		Prefix:     "v",
+		DryRun:     true,
+	}
+
+	// Without compat mode, the legacy form is reported instead of restarted
+	_, err = Execute(context.Background(), req)
+	assert.ErrorIs(t, err, version.ErrLegacyPrerelease)
+
+	req.PrereleaseCompat = true
+	result, err := Execute(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "1.4.0-rc1", result.PreviousVersion)
+	assert.Equal(t, "1.4.0-rc.2", result.NewVersion)
+}
+
+func TestExecute_Promote(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "feat: new feature")
+	runGit(t, tmpDir, "tag", "-a", "v1.1.0-rc.2", "-m", "Tested build")
+	createCommit(t, tmpDir, "feat: untested work on top of the rc")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	rc, err := repo.FindTag("v1.1.0-rc.2")
+	require.NoError(t, err)
+
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		Promote:    true,
+		Prefix:     "v",
+		NoPush:     true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1.1.0-rc.2", result.PreviousVersion)
+	assert.Equal(t, "1.1.0", result.NewVersion)
+	assert.Equal(t, "v1.1.0-rc.2", result.PromotedFrom)
+	assert.Equal(t, rc.CommitHash, result.CommitHash)
+
+	release, err := repo.FindTag("v1.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, rc.CommitHash, release.CommitHash)
+	assert.Contains(t, release.Message, "Promoted from v1.1.0-rc.2")
+	assert.Contains(t, release.Message, "Tested build")
+}
+
+func TestExecute_PromoteNamedTag(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.1.0-rc.1", "-m", "rc 1")
+	createCommit(t, tmpDir, "fix: rc fix")
+	runGit(t, tmpDir, "tag", "-a", "v1.1.0-rc.2", "-m", "rc 2")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	result, err := Execute(context.Background(), Request{
+		Repository:  repo,
+		Promote:     true,
+		PromoteFrom: "1.1.0-rc.1", // Prefix is optional
+		Prefix:      "v",
+		DryRun:      true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "v1.1.0-rc.1", result.PromotedFrom)
+	assert.Equal(t, "v1.1.0", result.TagName)
+}
+
+func TestExecute_PromoteStableTag(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	_, err = Execute(context.Background(), Request{
+		Repository: repo,
+		Promote:    true,
+		Prefix:     "v",
+		DryRun:     true,
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a prerelease")
+}
+
+func TestExecute_At(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "fix: old fix")
+	createCommit(t, tmpDir, "feat: later feature")
+	runGit(t, tmpDir, "tag", "-a", "v1.1.0", "-m", "Release v1.1.0")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	target, err := repo.ResolveRevision("HEAD~1")
+	require.NoError(t, err)
+
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpPatch,
+		At:         "HEAD~1",
+		Prefix:     "v",
+		NoPush:     true,
+	})
+
+	require.NoError(t, err)
+	// v1.1.0 is not reachable from HEAD~1
+	assert.Equal(t, "1.0.0", result.PreviousVersion)
+	assert.Equal(t, "1.0.1", result.NewVersion)
+	assert.Equal(t, target.String(), result.CommitHash)
+
+	tag, err := repo.FindTag("v1.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, target.String(), tag.CommitHash)
+}
+
+func TestExecute_AtInvalidRevision(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	_, err = Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpPatch,
+		At:         "does-not-exist",
+		DryRun:     true,
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to resolve revision")
+}
+
+func TestExecute_VersionFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "feat: new feature")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	before, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpMinor,
+		Prefix:     "v",
+		NoPush:     true,
+		VersionFile: VersionFile{
+			Path:    "version.go",
+			Var:     "Version",
+			Package: "main",
+		},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.VersionCommitted)
+	assert.NotEqual(t, before.String(), result.CommitHash)
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, "version.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `const Version = "1.1.0"`)
+
+	tag, err := repo.FindTag("v1.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, result.CommitHash, tag.CommitHash)
+
+	commit, err := repo.GetCommit(plumbing.NewHash(tag.CommitHash))
+	require.NoError(t, err)
+	assert.Equal(t, "chore(release): v1.1.0", commit.Subject)
+}
+
+func TestExecute_VersionFileTagFails(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "feat: new feature")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+	before, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	// Forcing the duplicate passes the version checks, but the tag exists
+	_, err = Execute(context.Background(), Request{
+		Repository:    repo,
+		BumpType:      version.BumpCustom,
+		CustomVersion: "1.0.0",
+		Force:         true,
+		Prefix:        "v",
+		NoPush:        true,
+		VersionFile: VersionFile{
+			Path:    "version.go",
+			Var:     "Version",
+			Package: "main",
+		},
+	})
+	require.ErrorContains(t, err, "failed to create tag")
+
+	// The release commit was dropped, and the file with it
+	after, err := repo.GetHEAD()
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+	assert.NoFileExists(t, filepath.Join(tmpDir, "version.go"))
+	dirty, err := repo.IsDirty(context.Background())
+	require.NoError(t, err)
+	assert.False(t, dirty)
+}
+
+func TestExecute_CalVer(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v26.09.2", "-m", "Release v26.09.2")
+	createCommit(t, tmpDir, "feat: new feature")
+
+	scheme, err := version.NewCalVer("YY.0M.MICRO")
+	require.NoError(t, err)
+	scheme.Now = func() time.Time { return time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC) }
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+	repo.SetScheme(scheme)
+
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpPatch,
+		Prefix:     "v",
+		NoPush:     true,
+	})
+
+	require.NoError(t, err)
+	// v1.0.0 does not match the calver layout and is ignored
+	assert.Equal(t, "26.09.2", result.PreviousVersion)
+	assert.Equal(t, "26.10.0", result.NewVersion)
+	assert.Equal(t, "v26.10.0", result.TagName)
+
+	_, err = Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpMinor,
+		Prefix:     "v",
+		DryRun:     true,
+	})
+	assert.ErrorIs(t, err, version.ErrUnsupportedBump)
+}
+
+func TestUpdateVersionFile_ValueTemplate(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	_, committed, err := UpdateVersionFile(context.Background(), repo, VersionFile{
+		Path:    "version.go",
+		Var:     "PythonVersion",
+		Package: "main",
+		Value:   "{{ pep440 .Version }}",
+	}, "1.2.0-rc.1", "v1.2.0-rc.1")
+
+	require.NoError(t, err)
+	assert.True(t, committed)
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, "version.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `const PythonVersion = "1.2.0rc1"`)
+}
+
+func TestExecute_BuildMetadataInAnnotation(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "fix: bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	result, err := Execute(context.Background(), Request{
+		Repository:    repo,
+		BumpType:      version.BumpPatch,
+		BuildMetadata: "{{.ShortCommit}}.{{.Date}}",
+		Prefix:        "v",
+		NoPush:        true,
+	})
+
+	require.NoError(t, err)
+	metadata := head.String()[:7] + "." + time.Now().UTC().Format("20060102")
+	assert.Equal(t, "1.0.1+"+metadata, result.NewVersion)
+	assert.Equal(t, "v1.0.1", result.TagName)
+
+	tag, err := repo.FindTag("v1.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "Release v1.0.1\n\nBuild metadata: "+metadata, tag.Message)
+}
+
+func TestExecute_BuildMetadataInTag(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "fix: bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	result, err := Execute(context.Background(), Request{
+		Repository:     repo,
+		BumpType:       version.BumpPatch,
+		BuildMetadata:  "ci.42",
+		MetadataPolicy: version.MetadataInTag,
+		Prefix:         "v",
+		NoPush:         true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.1+ci.42", result.NewVersion)
+	assert.Equal(t, "v1.0.1+ci.42", result.TagName)
+
+	tag, err := repo.FindTag("v1.0.1+ci.42")
+	require.NoError(t, err)
+	assert.Equal(t, "Release v1.0.1+ci.42", tag.Message)
+}
+
+func TestExecute_InvalidBuildMetadata(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	_, err = Execute(context.Background(), Request{
+		Repository:    repo,
+		BumpType:      version.BumpPatch,
+		BuildMetadata: "feat/login",
+		Prefix:        "v",
+		DryRun:        true,
+	})
+	assert.Error(t, err)
+}
+
+func TestExecute_TagTypeWarning(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "fix: bug")
+	runGit(t, tmpDir, "tag", "v1.0.1")
+	createCommit(t, tmpDir, "fix: another bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+	repo.SetTagType(git.TagAnnotated, git.TagTypeWarn)
+
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpPatch,
+		DryRun:     true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.2", result.TagName)
+	assert.Equal(t, []string{"tag v1.0.1 is lightweight, expected annotated"}, result.Warnings)
+}
+
+func TestExecute_LightweightDropsAnnotation(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "fix: bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+	repo.SetTagType(git.TagLightweight, git.TagTypeAllow)
+
+	result, err := Execute(context.Background(), Request{
+		Repository:    repo,
+		BumpType:      version.BumpPatch,
+		Message:       "Release {{.Tag}}",
+		BuildMetadata: "ci.42",
+		Prefix:        "v",
+		NoPush:        true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"lightweight tags have no annotation; not recorded: tag message, build metadata ci.42",
+	}, result.Warnings)
+
+	// Without anything to record there is nothing to warn about
+	createCommit(t, tmpDir, "fix: another bug")
+	result, err = Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpPatch,
+		Prefix:     "v",
+		NoPush:     true,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, result.Warnings)
+}
@@ -0,0 +1,166 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+// Description describes HEAD relative to the nearest version tag, like
+// `git describe --tags --dirty`
+type Description struct {
+	Tag        string           // Latest version tag reachable from HEAD, empty if none
+	Version    *version.Version // Version of Tag, nil if there is none
+	Commits    int              // Commits since Tag
+	Hash       string           // Full hash of HEAD
+	CommitTime time.Time        // Committer time of HEAD
+	Dirty      bool             // Tracked files have uncommitted changes
+}
+
+// Describe describes HEAD relative to the latest tag with the given prefix
+// that is reachable from HEAD, so a maintenance branch describes from its own line
+func Describe(ctx context.Context, repo *git.Repository, prefix string) (*Description, error) {
+	head, err := repo.GetHEAD()
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := repo.GetCommit(head)
+	if err != nil {
+		return nil, err
+	}
+
+	latestTag, err := repo.LatestTagAt(prefix, head)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest tag: %w", err)
+	}
+
+	var commits []*git.Commit
+	if latestTag != nil {
+		commits, err = repo.GetCommitsSinceTagAt(latestTag.Name, head)
+	} else {
+		commits, err = repo.GetAllCommitsAt(head)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commits: %w", err)
+	}
+
+	dirty, err := repo.IsDirty(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Description{
+		Commits:    len(commits),
+		Hash:       head.String(),
+		CommitTime: commit.CommitTime,
+		Dirty:      dirty,
+	}
+	if latestTag != nil {
+		d.Tag = latestTag.Name
+		d.Version = latestTag.Version
+	}
+
+	return d, nil
+}
+
+// String returns the git describe form, e.g. v1.4.2-5-gabc1234-dirty.
+// On a tagged commit it is just the tag; without tags it is the short hash.
+func (d *Description) String() string {
+	var s string
+	switch {
+	case d.Tag == "":
+		s = d.shortHash()
+	case d.Commits == 0:
+		s = d.Tag
+	default:
+		s = fmt.Sprintf("%s-%d-g%s", d.Tag, d.Commits, d.shortHash())
+	}
+
+	if d.Dirty {
+		s += "-dirty"
+	}
+	return s
+}
+
+// Semver returns a valid semver that sorts after the tag, e.g.
+// 1.4.3-dev.5+abc1234 after 1.4.2, or 1.5.0-rc.1.dev.5+abc1234 after 1.5.0-rc.1.
+// On a clean tagged commit it is the tag's version.
+func (d *Description) Semver() string {
+	base := d.base()
+	if d.Commits == 0 && !d.Dirty && d.Version != nil {
+		return base.String()
+	}
+
+	v := version.Version{Major: base.Major, Minor: base.Minor, Patch: base.Patch}
+	dev := fmt.Sprintf("dev.%d", d.Commits)
+	if base.IsPrerelease() {
+		v.Prerelease = base.Prerelease + "." + dev
+	} else {
+		v.Patch++
+		v.Prerelease = dev
+	}
+
+	v.Metadata = d.shortHash()
+	if d.Dirty {
+		v.Metadata += ".dirty"
+	}
+	return v.String()
+}
+
+// GoPseudo returns a Go module pseudo-version, e.g.
+// v1.4.3-0.20240102150405-abcdef123456 after v1.4.2. On a tagged commit it is
+// the tag's version. A dirty worktree adds +dirty, like Go's VCS stamping.
+func (d *Description) GoPseudo() string {
+	base := d.base()
+	stamp := d.CommitTime.UTC().Format("20060102150405")
+	hash := d.Hash
+	if len(hash) > 12 {
+		hash = hash[:12]
+	}
+
+	var s string
+	switch {
+	case d.Version == nil:
+		s = fmt.Sprintf("v0.0.0-%s-%s", stamp, hash)
+	case d.Commits == 0:
+		s = "v" + version.Version{
+			Major:      base.Major,
+			Minor:      base.Minor,
+			Patch:      base.Patch,
+			Prerelease: base.Prerelease,
+		}.String()
+	case base.IsPrerelease():
+		s = fmt.Sprintf("v%d.%d.%d-%s.0.%s-%s",
+			base.Major, base.Minor, base.Patch, base.Prerelease, stamp, hash)
+	default:
+		s = fmt.Sprintf("v%d.%d.%d-0.%s-%s",
+			base.Major, base.Minor, base.Patch+1, stamp, hash)
+	}
+
+	if d.Dirty {
+		s += "+dirty"
+	}
+	return s
+}
+
+// base returns the tag's version without metadata, or 0.0.0
+func (d *Description) base() version.Version {
+	if d.Version == nil {
+		return version.Zero()
+	}
+	v := *d.Version
+	v.Metadata = ""
+	return v
+}
+
+// shortHash returns the abbreviated hash of HEAD
+func (d *Description) shortHash() string {
+	if len(d.Hash) > 7 {
+		return d.Hash[:7]
+	}
+	return d.Hash
+}
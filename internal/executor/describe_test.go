@@ -0,0 +1,134 @@
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+const testHash = "abc1234def5678abc1234def5678abc1234def56"
+
+func TestDescription_Forms(t *testing.T) {
+	commitTime := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	v142 := version.Version{Major: 1, Minor: 4, Patch: 2}
+	rc := version.Version{Major: 1, Minor: 5, Prerelease: "rc.1"}
+
+	tests := []struct {
+		name   string
+		desc   Description
+		git    string
+		semver string
+		goVer  string
+	}{
+		{
+			name:   "commits after tag",
+			desc:   Description{Tag: "v1.4.2", Version: &v142, Commits: 5},
+			git:    "v1.4.2-5-gabc1234",
+			semver: "1.4.3-dev.5+abc1234",
+			goVer:  "v1.4.3-0.20240102150405-abc1234def56",
+		},
+		{
+			name:   "dirty",
+			desc:   Description{Tag: "v1.4.2", Version: &v142, Commits: 5, Dirty: true},
+			git:    "v1.4.2-5-gabc1234-dirty",
+			semver: "1.4.3-dev.5+abc1234.dirty",
+			goVer:  "v1.4.3-0.20240102150405-abc1234def56+dirty",
+		},
+		{
+			name:   "on tag",
+			desc:   Description{Tag: "v1.4.2", Version: &v142},
+			git:    "v1.4.2",
+			semver: "1.4.2",
+			goVer:  "v1.4.2",
+		},
+		{
+			name:   "after prerelease",
+			desc:   Description{Tag: "v1.5.0-rc.1", Version: &rc, Commits: 2},
+			git:    "v1.5.0-rc.1-2-gabc1234",
+			semver: "1.5.0-rc.1.dev.2+abc1234",
+			goVer:  "v1.5.0-rc.1.0.20240102150405-abc1234def56",
+		},
+		{
+			name:   "no tags",
+			desc:   Description{Commits: 3},
+			git:    "abc1234",
+			semver: "0.0.1-dev.3+abc1234",
+			goVer:  "v0.0.0-20240102150405-abc1234def56",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.desc.Hash = testHash
+			tt.desc.CommitTime = commitTime
+
+			assert.Equal(t, tt.git, tt.desc.String())
+			assert.Equal(t, tt.semver, tt.desc.Semver())
+			assert.Equal(t, tt.goVer, tt.desc.GoPseudo())
+		})
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "feat: one")
+	createCommit(t, tmpDir, "fix: two")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	desc, err := Describe(context.Background(), repo, "v")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.0-2-g"+head.String()[:7], desc.String())
+	assert.False(t, desc.Dirty)
+
+	// Modify a tracked file
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "README.md"), []byte("changed\n"), 0o644))
+
+	desc, err = Describe(context.Background(), repo, "v")
+	require.NoError(t, err)
+	assert.True(t, desc.Dirty)
+	assert.Equal(t, "1.0.1-dev.2+"+head.String()[:7]+".dirty", desc.Semver())
+}
+
+func TestDescribe_MaintenanceBranch(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.4.2", "-m", "Release 1.4.2")
+	main := getCurrentBranch(t, tmpDir)
+
+	runGit(t, tmpDir, "checkout", "-b", "release/1.4")
+	createCommit(t, tmpDir, "fix: backport")
+
+	runGit(t, tmpDir, "checkout", main)
+	createCommit(t, tmpDir, "feat!: breaking")
+	runGit(t, tmpDir, "tag", "-a", "v2.0.0", "-m", "Release 2.0.0")
+	runGit(t, tmpDir, "checkout", "release/1.4")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	desc, err := Describe(context.Background(), repo, "v")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.4.2", desc.Tag)
+	assert.Equal(t, 1, desc.Commits)
+	assert.Equal(t, "v1.4.2-1-g"+head.String()[:7], desc.String())
+	assert.Equal(t, "1.4.3-dev.1+"+head.String()[:7], desc.Semver())
+}
@@ -0,0 +1,87 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+// ErrShallowHistory is returned when a shallow clone cannot be deepened to a
+// release tag, so the commits since the release would be truncated
+var ErrShallowHistory = errors.New("shallow clone does not reach a release")
+
+// DefaultMaxFetchDepth is how many commits FetchTags deepens a shallow clone
+// by at most
+const DefaultMaxFetchDepth = 10000
+
+// fetchDepthStep is the first deepening step, doubled on every round
+const fetchDepthStep = 50
+
+// FetchTags fetches the remote's tags, so the next version follows releases
+// tagged elsewhere rather than only the local tags. In a shallow clone it then
+// deepens the history, at most maxDepth commits, until a release tag of prefix
+// is reachable from HEAD, or the clone is complete. The reachable tag need not
+// be the latest one, which may only be on another branch. Otherwise it fails
+// with ErrShallowHistory. Without the remote it does nothing.
+func FetchTags(
+	ctx context.Context,
+	repo *git.Repository,
+	remote, prefix string,
+	maxDepth int,
+) error {
+	hasRemote, err := repo.HasRemote(remote)
+	if err != nil {
+		return fmt.Errorf("failed to check remote: %w", err)
+	}
+	if !hasRemote {
+		return nil
+	}
+	if err := repo.FetchTags(ctx, remote, prefix); err != nil {
+		return fmt.Errorf("failed to fetch tags: %w", err)
+	}
+
+	latest, err := repo.LatestTag(prefix)
+	if err != nil {
+		return fmt.Errorf("failed to get latest tag: %w", err)
+	}
+	// Without any release the whole history is analysed
+	release := "the first release"
+	if latest != nil {
+		release = "the last release of HEAD"
+	}
+
+	deepened := 0
+	for step := fetchDepthStep; ; step *= 2 {
+		shallow, err := repo.IsShallow()
+		if err != nil {
+			return err
+		}
+		if !shallow {
+			return nil
+		}
+		if latest != nil {
+			tag, err := repo.ReachableTag(prefix)
+			if err != nil {
+				return err
+			}
+			if tag != nil {
+				return nil
+			}
+		}
+
+		if deepened >= maxDepth {
+			return fmt.Errorf(
+				"%w: %s is more than %d commits below the shallow boundary "+
+					"(fetch the full history with `git fetch --unshallow` or raise fetch.max-depth)",
+				ErrShallowHistory, release, maxDepth,
+			)
+		}
+		commits := min(step, maxDepth-deepened)
+		if err := repo.Deepen(ctx, remote, commits); err != nil {
+			return fmt.Errorf("%w: %w", ErrShallowHistory, err)
+		}
+		deepened += commits
+	}
+}
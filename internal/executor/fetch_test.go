@@ -0,0 +1,95 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+// shallowClone returns a depth 1 clone of a repository with v1.0.0 tagged
+// below the given number of later commits
+func shallowClone(t *testing.T, later int) *git.Repository {
+	t.Helper()
+	srcDir := t.TempDir()
+	initRealGitRepo(t, srcDir)
+	createTag(t, srcDir)
+	for i := range later {
+		createCommit(t, srcDir, fmt.Sprintf("fix: bug %d", i))
+	}
+
+	dir := t.TempDir()
+	runGit(t, dir, "clone", "--depth", "1", "--no-tags", "file://"+srcDir, ".")
+	repo, err := git.Open(dir)
+	require.NoError(t, err)
+	return repo
+}
+
+func TestFetchTags_Shallow(t *testing.T) {
+	repo := shallowClone(t, 120)
+	require.NoError(t, FetchTags(context.Background(), repo, "origin", "v", DefaultMaxFetchDepth))
+
+	// The history reaches the release, so the commits since it are all seen
+	commits, err := repo.GetCommitsSinceTag("v1.0.0")
+	require.NoError(t, err)
+	assert.Len(t, commits, 120)
+}
+
+func TestFetchTags_TooShallow(t *testing.T) {
+	repo := shallowClone(t, 120)
+	err := FetchTags(context.Background(), repo, "origin", "v", 60)
+	require.ErrorIs(t, err, ErrShallowHistory)
+	assert.ErrorContains(t, err, "the last release of HEAD is more than 60 commits")
+}
+
+func TestFetchTags_ReleaseOnOtherBranch(t *testing.T) {
+	srcDir := t.TempDir()
+	initRealGitRepo(t, srcDir)
+	for i := range 30 {
+		createCommit(t, srcDir, fmt.Sprintf("feat: early feature %d", i))
+	}
+	createTag(t, srcDir)
+	main := getCurrentBranch(t, srcDir)
+
+	// v1.0.1 is one commit below the tip of maint
+	runGit(t, srcDir, "checkout", "-b", "maint")
+	createCommit(t, srcDir, "fix: backport")
+	runGit(t, srcDir, "tag", "-a", "v1.0.1", "-m", "Release 1.0.1")
+	createCommit(t, srcDir, "fix: another backport")
+
+	// v2.0.0 is only on main, so it never becomes reachable from maint
+	runGit(t, srcDir, "checkout", main)
+	for i := range 30 {
+		createCommit(t, srcDir, fmt.Sprintf("feat: feature %d", i))
+	}
+	runGit(t, srcDir, "tag", "-a", "v2.0.0", "-m", "Release 2.0.0")
+
+	dir := t.TempDir()
+	runGit(t, dir, "clone", "--depth", "1", "--branch", "maint", "--no-tags",
+		"file://"+srcDir, ".")
+	repo, err := git.Open(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, FetchTags(context.Background(), repo, "origin", "v", 10))
+
+	// Only the history down to v1.0.1 was fetched, not all of it
+	shallow, err := repo.IsShallow()
+	require.NoError(t, err)
+	assert.True(t, shallow)
+	commits, err := repo.GetCommitsSinceTag("v1.0.1")
+	require.NoError(t, err)
+	assert.Len(t, commits, 1)
+}
+
+func TestFetchTags_NoRemote(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	assert.NoError(t, FetchTags(context.Background(), repo, "origin", "v", DefaultMaxFetchDepth))
+}
@@ -0,0 +1,48 @@
+package executor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+// FloatingTags returns the floating tags, like v1 and v1.4, to move to the
+// stable release v. A floating tag only moves when v is the latest stable
+// release of its line, so a hotfix on an old line leaves v1 where it is.
+func FloatingTags(repo *git.Repository, prefix string, v version.Version) ([]string, error) {
+	if v.IsPrerelease() {
+		return nil, nil
+	}
+
+	tags, err := repo.ListTags()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	newerMajor, newerMinor := false, false
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag.Name, prefix) || tag.Version == nil {
+			continue
+		}
+		other := *tag.Version
+		if other.IsPrerelease() || other.Major != v.Major || !v.LessThan(other) {
+			continue
+		}
+		newerMajor = true
+		if other.Minor == v.Minor {
+			newerMinor = true
+		}
+	}
+
+	floating := version.FloatingVersions(v)
+	var names []string
+	if !newerMajor {
+		names = append(names, prefix+floating[0])
+	}
+	if !newerMinor {
+		names = append(names, prefix+floating[1])
+	}
+	return names, nil
+}
@@ -0,0 +1,111 @@
+package executor
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+func revParse(t *testing.T, dir, rev string) string {
+	t.Helper()
+	out, err := exec.CommandContext(t.Context(), "git", "-C", dir, "rev-parse", rev).Output()
+	require.NoError(t, err)
+	return strings.TrimSpace(string(out))
+}
+
+func TestExecute_FloatingTags(t *testing.T) {
+	remoteDir := t.TempDir()
+	runGit(t, remoteDir, "init", "--bare")
+
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "remote", "add", "origin", remoteDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.4.1", "-m", "Release v1.4.1")
+	runGit(t, tmpDir, "tag", "v1")
+	runGit(t, tmpDir, "tag", "v1.4")
+	runGit(t, tmpDir, "push", "origin", "--tags")
+	createCommit(t, tmpDir, "fix: bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpPatch,
+		Prefix:     "v",
+		Remote:     "origin",
+		Floating:   true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1.4.1", result.PreviousVersion)
+	assert.Equal(t, []string{"v1", "v1.4"}, result.FloatingTags)
+	assert.True(t, result.Pushed)
+
+	head := revParse(t, tmpDir, "HEAD")
+	for _, name := range result.FloatingTags {
+		assert.Equal(t, head, revParse(t, tmpDir, name+"^{commit}"))
+		assert.Equal(t, head, revParse(t, remoteDir, name+"^{commit}"))
+	}
+}
+
+func TestExecute_FloatingTagsSkipPrerelease(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "feat: feature")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpPrereleaseBeta,
+		Prefix:     "v",
+		NoPush:     true,
+		Floating:   true,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.FloatingTags)
+	_, err = repo.FindTag("v1")
+	assert.Error(t, err)
+}
+
+func TestFloatingTags_OldLine(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.8.2", "-m", "Release v1.8.2")
+	runGit(t, tmpDir, "tag", "-a", "v1.9.0", "-m", "Release v1.9.0")
+	runGit(t, tmpDir, "tag", "-a", "v2.0.0-rc.1", "-m", "Release v2.0.0-rc.1")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	tests := []struct {
+		version  string
+		expected []string
+	}{
+		{"1.8.3", []string{"v1.8"}},
+		{"1.9.1", []string{"v1", "v1.9"}},
+		{"2.0.0", []string{"v2", "v2.0"}},
+		{"2.0.0-rc.2", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			v, err := version.Parse(tt.version)
+			require.NoError(t, err)
+			names, err := FloatingTags(repo, "v", v)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
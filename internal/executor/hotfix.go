@@ -0,0 +1,158 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+// HotfixRequest contains the parameters for a hotfix release
+type HotfixRequest struct {
+	Repository    *git.Repository
+	BaseTag       string   // Release to fix, with or without prefix (e.g. v1.8.2)
+	CherryPick    []string // Commits to apply on the hotfix branch, oldest first
+	Prefix        string   // Tag prefix (default: "v")
+	Remote        string   // Remote name (default: "origin")
+	DryRun        bool     // If true, don't touch branches or tags
+	NoPush        bool     // If true, don't push the branch or tag
+	NoHooks       bool     // If true, skip hook execution
+	Floating      bool     // If true, move floating tags that the hotfix is the latest of
+	PreTagHooks   []string
+	PostTagHooks  []string
+	PostPushHooks []string
+	HookTemplates bool         // If true, hook commands are expanded as Go templates
+	Verifier      git.Verifier // If set, verify the signatures of earlier release tags
+	RequireSigned bool         // If true, unverified release tags abort the hotfix
+	Message       string       // Tag message template (default: "Release <tag>")
+	Remotes       []PushRemote // Remotes the hotfix is pushed to (default: Remote, required)
+}
+
+// HotfixResult contains the outcome of a hotfix release
+type HotfixResult struct {
+	*Result
+	Branch        string   // Maintenance branch, e.g. hotfix/1.8.x
+	BaseTag       string   // Tag the branch was created from
+	BranchCreated bool     // False if the branch already existed
+	CherryPicked  []string // Commits applied on the branch
+	BranchPushed  bool
+}
+
+// HotfixBranch returns the maintenance branch for the version line of v,
+// e.g. hotfix/1.8.x for 1.8.2
+func HotfixBranch(v version.Version) string {
+	return fmt.Sprintf("hotfix/%d.%d.x", v.Major, v.Minor)
+}
+
+// HotfixBase resolves the release tag a hotfix starts from
+func HotfixBase(repo *git.Repository, prefix, name string) (*git.Tag, error) {
+	base, err := findTag(repo, prefix, name)
+	if err != nil {
+		return nil, err
+	}
+	if base.Version == nil {
+		return nil, fmt.Errorf("tag %q is not a version tag", base.Name)
+	}
+	return base, nil
+}
+
+// Hotfix releases a patch on the version line of an older tag. It checks out
+// the line's hotfix branch (creating it from the tag if needed), cherry-picks
+// the requested commits, and tags the next patch version computed from the
+// tags reachable on that branch rather than the global latest tag.
+func Hotfix(ctx context.Context, req HotfixRequest) (*HotfixResult, error) {
+	if req.Prefix == "" {
+		req.Prefix = "v"
+	}
+	if req.Remote == "" {
+		req.Remote = "origin"
+	}
+
+	base, err := HotfixBase(req.Repository, req.Prefix, req.BaseTag)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := HotfixBranch(*base.Version)
+	exists, err := req.Repository.HasBranch(branch)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &HotfixResult{
+		Branch:        branch,
+		BaseTag:       base.Name,
+		BranchCreated: !exists,
+	}
+
+	bumpReq := Request{
+		Repository:    req.Repository,
+		BumpType:      version.BumpPatch,
+		At:            "HEAD",
+		Prefix:        req.Prefix,
+		Remote:        req.Remote,
+		PushBranch:    branch,
+		Remotes:       req.Remotes,
+		DryRun:        req.DryRun,
+		NoPush:        req.NoPush,
+		NoHooks:       req.NoHooks,
+		Floating:      req.Floating,
+		Verifier:      req.Verifier,
+		RequireSigned: req.RequireSigned,
+		Message:       req.Message,
+		PreTagHooks:   req.PreTagHooks,
+		PostTagHooks:  req.PostTagHooks,
+		PostPushHooks: req.PostPushHooks,
+		HookTemplates: req.HookTemplates,
+	}
+
+	if req.DryRun {
+		// Compute the version from where the branch is or would be
+		bumpReq.At = base.Name
+		if exists {
+			bumpReq.At = branch
+		}
+		result.Result, err = Execute(ctx, bumpReq)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	if req.Repository.IsBare() {
+		return nil, fmt.Errorf("%w: hotfixes check out %s", git.ErrBareRepository, branch)
+	}
+
+	// Remember where the user was, to return there if the picks fail
+	original, err := req.Repository.GetCurrentBranch()
+	if err != nil {
+		head, headErr := req.Repository.GetHEAD()
+		if headErr != nil {
+			return nil, headErr
+		}
+		original = head.String()
+	}
+	if err := req.Repository.CheckoutBranch(ctx, branch, base.Name); err != nil {
+		return nil, err
+	}
+
+	if err := req.Repository.CherryPick(ctx, req.CherryPick...); err != nil {
+		if checkoutErr := req.Repository.Checkout(ctx, original); checkoutErr != nil {
+			err = errors.Join(err, checkoutErr)
+		}
+		return result, err
+	}
+	result.CherryPicked = req.CherryPick
+
+	result.Result, err = Execute(ctx, bumpReq)
+	if err != nil {
+		return result, err
+	}
+
+	// The branch is pushed with the tag so the tagged commit is reachable
+	result.BranchPushed = result.Pushed
+
+	return result, nil
+}
@@ -0,0 +1,155 @@
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+func TestHotfixBranch(t *testing.T) {
+	assert.Equal(t, "hotfix/1.8.x", HotfixBranch(version.Version{Major: 1, Minor: 8, Patch: 2}))
+}
+
+func TestHotfix(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.8.2", "-m", "Release v1.8.2")
+	createCommit(t, tmpDir, "feat!: new major")
+	runGit(t, tmpDir, "tag", "-a", "v2.0.0", "-m", "Release v2.0.0")
+	createCommit(t, tmpDir, "fix: critical bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	fix, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	result, err := Hotfix(context.Background(), HotfixRequest{
+		Repository: repo,
+		BaseTag:    "1.8.2",
+		CherryPick: []string{fix.String()},
+		NoPush:     true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "hotfix/1.8.x", result.Branch)
+	assert.Equal(t, "v1.8.2", result.BaseTag)
+	assert.True(t, result.BranchCreated)
+	assert.Equal(t, []string{fix.String()}, result.CherryPicked)
+	assert.Equal(t, "1.8.2", result.PreviousVersion)
+	assert.Equal(t, "v1.8.3", result.TagName)
+	assert.True(t, result.TagCreated)
+
+	branch, err := repo.GetCurrentBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "hotfix/1.8.x", branch)
+
+	// A second hotfix continues on the same line
+	second, err := Hotfix(context.Background(), HotfixRequest{
+		Repository: repo,
+		BaseTag:    "v1.8.2",
+		DryRun:     true,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, second.BranchCreated)
+	assert.Equal(t, "v1.8.4", second.TagName)
+}
+
+func TestHotfix_DryRun(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.8.2", "-m", "Release v1.8.2")
+	createCommit(t, tmpDir, "feat: newer work")
+	runGit(t, tmpDir, "tag", "-a", "v1.9.0", "-m", "Release v1.9.0")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	result, err := Hotfix(context.Background(), HotfixRequest{
+		Repository: repo,
+		BaseTag:    "v1.8.2",
+		DryRun:     true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "v1.8.3", result.TagName)
+	assert.False(t, result.TagCreated)
+
+	exists, err := repo.HasBranch("hotfix/1.8.x")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestHotfix_ConflictRestoresBranch(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.8.2", "-m", "Release v1.8.2")
+	createCommit(t, tmpDir, "fix: clean change")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	clean, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	// Edits a file that does not exist at v1.8.2, so it cannot be picked
+	notes := filepath.Join(tmpDir, "NOTES.md")
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(notes, []byte("# Notes\n"), 0o644))
+	runGit(t, tmpDir, "add", ".")
+	runGit(t, tmpDir, "commit", "-m", "docs: add notes")
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(notes, []byte("# Release notes\n"), 0o644))
+	runGit(t, tmpDir, "commit", "-am", "docs: rename notes")
+	conflicting, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	original, err := repo.GetCurrentBranch()
+	require.NoError(t, err)
+
+	_, err = Hotfix(context.Background(), HotfixRequest{
+		Repository: repo,
+		BaseTag:    "v1.8.2",
+		CherryPick: []string{clean.String(), conflicting.String()},
+		NoPush:     true,
+	})
+	require.Error(t, err)
+
+	branch, err := repo.GetCurrentBranch()
+	require.NoError(t, err)
+	assert.Equal(t, original, branch)
+
+	// The hotfix branch holds no partial picks and nothing was tagged
+	base, err := repo.ResolveRevision("v1.8.2")
+	require.NoError(t, err)
+	tip, err := repo.ResolveRevision("hotfix/1.8.x")
+	require.NoError(t, err)
+	assert.Equal(t, base, tip)
+	_, err = repo.FindTag("v1.8.3")
+	assert.Error(t, err)
+}
+
+func TestHotfix_UnknownTag(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	_, err = Hotfix(context.Background(), HotfixRequest{
+		Repository: repo,
+		BaseTag:    "v1.8.2",
+		DryRun:     true,
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
@@ -0,0 +1,209 @@
+package executor
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/benny123tw/bumpkin/internal/conventional"
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+// MessageData is the data for tag message templates, e.g.
+// "Release {{.Tag}}\n\n{{.Changelog}}"
+type MessageData struct {
+	Tag             string         // Name of the new tag
+	Version         string         // New version, without prefix
+	PreviousTag     string         // Release the changelog starts from, empty for the first
+	PreviousVersion string         // Version of PreviousTag
+	Date            string         // UTC release date, YYYY-MM-DD
+	Commits         int            // Number of commits since PreviousTag
+	Features        int            // Number of feat commits
+	Fixes           int            // Number of fix commits
+	Breaking        int            // Number of breaking changes
+	Types           map[string]int // Commits per conventional type; "other" for the rest
+	Contributors    []string       // Commit authors, in order of first contribution
+	Changelog       string         // Markdown changelog section, grouped by commit type
+}
+
+// changelogGroups are the changelog sections for conventional commit types,
+// in order. Commits of other types are listed under "Other Changes".
+var changelogGroups = []struct {
+	Type  string
+	Title string
+}{
+	{"feat", "Features"},
+	{"fix", "Bug Fixes"},
+	{"perf", "Performance Improvements"},
+}
+
+// TagMessage renders the annotation of a release tag. Without a template the
+// message is "Release <tag>". Templates see MessageData for the commits
+// between the previous release of prefix and hash: for a stable version the
+// previous stable release, so a release after prereleases covers all of them.
+func TagMessage(
+	repo *git.Repository,
+	tmpl, prefix, tagName string,
+	v version.Version,
+	hash plumbing.Hash,
+) (string, error) {
+	if tmpl == "" {
+		return fmt.Sprintf("Release %s", tagName), nil
+	}
+
+	scheme := repo.Scheme()
+	data := MessageData{
+		Tag:     tagName,
+		Version: scheme.Format(v),
+		Date:    time.Now().UTC().Format("2006-01-02"),
+	}
+
+	previous, err := previousRelease(repo, prefix, v, hash)
+	if err != nil {
+		return "", err
+	}
+	var commits []*git.Commit
+	if previous != nil {
+		data.PreviousTag = previous.Name
+		data.PreviousVersion = scheme.Format(*previous.Version)
+		commits, err = repo.GetCommitsSinceTagAt(previous.Name, hash)
+	} else {
+		commits, err = repo.GetAllCommitsAt(hash)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get commits for tag message: %w", err)
+	}
+	data.addCommits(commits)
+
+	message, err := version.Render(tmpl, data)
+	if err != nil {
+		return "", fmt.Errorf("failed to render tag message: %w", err)
+	}
+	message = strings.TrimSpace(message)
+	if message == "" {
+		return "", fmt.Errorf("tag message template rendered an empty message")
+	}
+	return message, nil
+}
+
+// previousRelease returns the highest release of prefix below v that is
+// reachable from hash, skipping prereleases when v is stable
+func previousRelease(
+	repo *git.Repository,
+	prefix string,
+	v version.Version,
+	hash plumbing.Hash,
+) (*git.Tag, error) {
+	tags, err := repo.TagsAt(prefix, hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	// Tags are sorted lowest version first
+	channels := repo.Channels()
+	for _, tag := range slices.Backward(tags) {
+		if channels.Compare(*tag.Version, v) >= 0 {
+			continue
+		}
+		if !v.IsPrerelease() && tag.Version.IsPrerelease() {
+			continue
+		}
+		return tag, nil
+	}
+	return nil, nil
+}
+
+// addCommits fills the counts, contributors and changelog from commits,
+// newest first
+func (d *MessageData) addCommits(commits []*git.Commit) {
+	d.Commits = len(commits)
+	d.Types = make(map[string]int)
+
+	var breaking []string
+	entries := make(map[string][]string)
+	for _, c := range commits {
+		cc, err := conventional.ParseCommit(c.Message)
+		if err != nil {
+			continue
+		}
+		d.Types[cc.Type]++
+
+		entry := changelogEntry(cc, c)
+		if cc.IsBreaking {
+			d.Breaking++
+			breaking = append(breaking, entry)
+			continue
+		}
+		group := "other"
+		for _, g := range changelogGroups {
+			if g.Type == cc.Type {
+				group = g.Type
+			}
+		}
+		entries[group] = append(entries[group], entry)
+	}
+	d.Features = d.Types["feat"]
+	d.Fixes = d.Types["fix"]
+
+	// Contributors in the order they first contributed
+	for _, c := range slices.Backward(commits) {
+		if c.Author != "" && !slices.Contains(d.Contributors, c.Author) {
+			d.Contributors = append(d.Contributors, c.Author)
+		}
+	}
+
+	var sections []string
+	addSection := func(title string, lines []string) {
+		if len(lines) > 0 {
+			sections = append(sections, "### "+title+"\n\n"+strings.Join(lines, "\n"))
+		}
+	}
+	addSection("Breaking Changes", breaking)
+	for _, g := range changelogGroups {
+		addSection(g.Title, entries[g.Type])
+	}
+	addSection("Other Changes", entries["other"])
+	d.Changelog = strings.Join(sections, "\n\n")
+}
+
+// changelogEntry formats a commit as a changelog list item, e.g.
+// "- **cli:** add --repo flag (abc1234)"
+func changelogEntry(cc *conventional.ConventionalCommit, c *git.Commit) string {
+	description := cc.Description
+	if description == "" {
+		description = c.Subject
+	}
+	if cc.Scope != "" {
+		description = fmt.Sprintf("**%s:** %s", cc.Scope, description)
+	}
+	return fmt.Sprintf("- %s (%s)", description, c.ShortHash)
+}
+
+// LightweightWarning returns a warning naming what a lightweight tag cannot
+// record, since it has no annotation: a message template, the reason for
+// forcing, or build metadata kept out of the tag name. Returns an empty
+// string for annotated tags or when nothing is lost.
+func LightweightWarning(repo *git.Repository, tmpl, forceReason, metadata string) string {
+	if repo.TagType() != git.TagLightweight {
+		return ""
+	}
+
+	var dropped []string
+	if tmpl != "" {
+		dropped = append(dropped, "tag message")
+	}
+	if forceReason != "" {
+		dropped = append(dropped, "force reason")
+	}
+	if metadata != "" {
+		dropped = append(dropped, "build metadata "+metadata)
+	}
+	if len(dropped) == 0 {
+		return ""
+	}
+	return "lightweight tags have no annotation; not recorded: " + strings.Join(dropped, ", ")
+}
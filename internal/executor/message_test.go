@@ -0,0 +1,104 @@
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+func TestExecute_TagMessageTemplate(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "feat(cli): add --message flag")
+	runGit(t, tmpDir, "commit", "--allow-empty", "-m", "fix: handle empty tags",
+		"--author", "Second Author <second@example.com>")
+	createCommit(t, tmpDir, "chore: update dependencies")
+	createCommit(t, tmpDir, "feat!: drop the legacy config format")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpMajor,
+		NoPush:     true,
+		Message: "Release {{.Tag}} ({{.Commits}} commits, {{.Features}} features, " +
+			"{{.Fixes}} fixes, {{.Breaking}} breaking) since {{.PreviousTag}}\n\n" +
+			"{{.Changelog}}\n\nContributors: {{join .Contributors \", \"}}",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "v2.0.0", result.TagName)
+
+	tag, err := repo.FindTag("v2.0.0")
+	require.NoError(t, err)
+	assert.Contains(t, tag.Message,
+		"Release v2.0.0 (4 commits, 2 features, 1 fixes, 1 breaking) since v1.0.0")
+	assert.Regexp(t, `### Breaking Changes\n\n- drop the legacy config format \(\w{7}\)`, tag.Message)
+	assert.Regexp(t, `### Features\n\n- \*\*cli:\*\* add --message flag \(\w{7}\)`, tag.Message)
+	assert.Regexp(t, `### Bug Fixes\n\n- handle empty tags \(\w{7}\)`, tag.Message)
+	assert.Regexp(t, `### Other Changes\n\n- update dependencies \(\w{7}\)`, tag.Message)
+	assert.Contains(t, tag.Message, "Contributors: Test User, Second Author")
+}
+
+func TestTagMessage_StableReleaseSkipsPrereleases(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "feat: first")
+	runGit(t, tmpDir, "tag", "-a", "v1.1.0-rc.1", "-m", "Release v1.1.0-rc.1")
+	createCommit(t, tmpDir, "fix: second")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	stable, err := version.Parse("1.1.0")
+	require.NoError(t, err)
+	rc, err := version.Parse("1.1.0-rc.2")
+	require.NoError(t, err)
+
+	tmpl := "{{.PreviousTag}} {{.PreviousVersion}} {{.Commits}}"
+	message, err := TagMessage(repo, tmpl, "v", "v1.1.0", stable, head)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.0 1.0.0 2", message)
+
+	// Prereleases continue from the latest prerelease
+	message, err = TagMessage(repo, tmpl, "v", "v1.1.0-rc.2", rc, head)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.1.0-rc.1 1.1.0-rc.1 1", message)
+}
+
+func TestTagMessage(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+	v, err := version.Parse("1.0.0")
+	require.NoError(t, err)
+
+	// Without a template
+	message, err := TagMessage(repo, "", "v", "v1.0.0", v, head)
+	require.NoError(t, err)
+	assert.Equal(t, "Release v1.0.0", message)
+
+	// The first release covers all commits
+	message, err = TagMessage(repo, "{{.Tag}}: {{.Commits}} {{.PreviousTag}}", "v", "v1.0.0", v, head)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.0: 1", message)
+
+	_, err = TagMessage(repo, "{{.Unknown}}", "v", "v1.0.0", v, head)
+	assert.ErrorContains(t, err, "failed to render tag message")
+
+	_, err = TagMessage(repo, "{{if false}}x{{end}}", "v", "v1.0.0", v, head)
+	assert.ErrorContains(t, err, "empty message")
+}
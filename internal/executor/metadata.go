@@ -0,0 +1,54 @@
+package executor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+// MetadataData is the data for build metadata templates, e.g.
+// {{.ShortCommit}}.{{.Date}}
+type MetadataData struct {
+	Commit      string // Full hash of the commit being tagged
+	ShortCommit string // Abbreviated hash of the commit being tagged
+	Date        string // UTC build date, YYYYMMDD
+	Timestamp   string // UTC build time, YYYYMMDDhhmmss
+	Branch      string // Current branch, empty on a detached HEAD
+}
+
+// RenderMetadata renders a build metadata template for the commit being
+// tagged and validates the result. Text without template actions is used as-is.
+func RenderMetadata(repo *git.Repository, tmpl string, hash plumbing.Hash) (string, error) {
+	now := time.Now().UTC()
+	data := MetadataData{
+		Commit:      hash.String(),
+		ShortCommit: hash.String()[:7],
+		Date:        now.Format("20060102"),
+		Timestamp:   now.Format("20060102150405"),
+	}
+	if branch, err := repo.GetCurrentBranch(); err == nil {
+		data.Branch = version.SanitizeIdentifier(branch)
+	}
+
+	metadata, err := version.Render(tmpl, data)
+	if err != nil {
+		return "", fmt.Errorf("failed to render build metadata: %w", err)
+	}
+	if err := version.ValidateMetadata(metadata); err != nil {
+		return "", err
+	}
+	return metadata, nil
+}
+
+// MetadataMessage appends build metadata that is kept out of the tag name to
+// a tag annotation
+func MetadataMessage(message, metadata string) string {
+	if metadata == "" {
+		return message
+	}
+	return fmt.Sprintf("%s\n\nBuild metadata: %s", message, metadata)
+}
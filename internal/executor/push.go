@@ -0,0 +1,130 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+// PushRemote is a remote the release is pushed to
+type PushRemote struct {
+	Name       string
+	BestEffort bool // If true, a failed push is a warning instead of an error, e.g. for mirrors
+}
+
+// RemoteResult is the outcome of pushing the release to one remote
+type RemoteResult struct {
+	Remote     string
+	BestEffort bool
+	Pushed     bool
+	Error      string // Why the push failed, if it did
+}
+
+// pushRemotes returns the remotes to push to and warnings for the skipped
+// ones. Missing best-effort remotes are skipped with a warning and missing
+// required remotes are an error, except for the default remote: repositories
+// without one are simply not pushed. Required remotes that already have the
+// tag are an error too, before anything is created locally.
+func (r Request) pushRemotes(ctx context.Context, tagName string) ([]PushRemote, []string, error) {
+	remotes := r.Remotes
+	listed := len(remotes) > 0
+	if !listed {
+		remotes = []PushRemote{{Name: r.Remote}}
+	}
+
+	var found []PushRemote
+	var warnings []string
+	for _, remote := range remotes {
+		hasRemote, err := r.Repository.HasRemote(remote.Name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check remote: %w", err)
+		}
+		switch {
+		case hasRemote:
+			found = append(found, remote)
+			continue
+		case remote.BestEffort:
+			warnings = append(warnings, fmt.Sprintf("remote %q not found, not pushed", remote.Name))
+		case listed:
+			return nil, nil, fmt.Errorf("remote %q not found", remote.Name)
+		}
+	}
+
+	for _, remote := range found {
+		if remote.BestEffort {
+			continue
+		}
+		if err := r.Repository.CheckRemoteTag(ctx, remote.Name, tagName); err != nil {
+			return nil, nil, err
+		}
+	}
+	return found, warnings, nil
+}
+
+// PushRemotes pushes the release tag, with the branch unless it is empty, and
+// then the floating tags to each remote, required remotes first. It stops at
+// the first required remote that fails, while failed best-effort remotes are
+// returned as warnings.
+func PushRemotes(
+	ctx context.Context,
+	repo *git.Repository,
+	remotes []PushRemote,
+	branch, tagName string,
+	floatingTags []string,
+) ([]RemoteResult, []string, error) {
+	ordered := make([]PushRemote, 0, len(remotes))
+	for _, remote := range remotes {
+		if !remote.BestEffort {
+			ordered = append(ordered, remote)
+		}
+	}
+	for _, remote := range remotes {
+		if remote.BestEffort {
+			ordered = append(ordered, remote)
+		}
+	}
+
+	var results []RemoteResult
+	var warnings []string
+	for _, remote := range ordered {
+		err := pushRemote(ctx, repo, remote.Name, branch, tagName, floatingTags)
+		result := RemoteResult{
+			Remote:     remote.Name,
+			BestEffort: remote.BestEffort,
+			Pushed:     err == nil,
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+
+		switch {
+		case err == nil:
+		case remote.BestEffort:
+			warnings = append(warnings, fmt.Sprintf("push to %s failed: %v", remote.Name, err))
+		default:
+			return results, warnings, err
+		}
+	}
+	return results, warnings, nil
+}
+
+// pushRemote pushes the release, then the floating tags, to one remote
+func pushRemote(
+	ctx context.Context,
+	repo *git.Repository,
+	remoteName, branch, tagName string,
+	floatingTags []string,
+) error {
+	if err := repo.PushRelease(ctx, remoteName, branch, tagName); err != nil {
+		return fmt.Errorf("failed to push release: %w", err)
+	}
+	// Floating tags already exist on the remote, so only they are forced
+	for _, name := range floatingTags {
+		if err := repo.ForcePushTag(ctx, name, remoteName); err != nil {
+			return fmt.Errorf("failed to push floating tag: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package executor
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+// setupMirrors returns a repository with v1.0.0 and a new commit, pushed to a
+// bare origin, with a bare mirror and a mirror whose path does not exist
+func setupMirrors(t *testing.T) (*git.Repository, string, string) {
+	t.Helper()
+	originDir := t.TempDir()
+	runGit(t, originDir, "init", "--bare")
+	mirrorDir := t.TempDir()
+	runGit(t, mirrorDir, "init", "--bare")
+
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "remote", "add", "origin", originDir)
+	runGit(t, tmpDir, "remote", "add", "mirror", mirrorDir)
+	runGit(t, tmpDir, "remote", "add", "offline", filepath.Join(t.TempDir(), "missing.git"))
+	createTag(t, tmpDir)
+	runGit(t, tmpDir, "push", "origin", getCurrentBranch(t, tmpDir), "v1.0.0")
+	createCommit(t, tmpDir, "fix: bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+	return repo, originDir, mirrorDir
+}
+
+func TestExecute_Remotes(t *testing.T) {
+	repo, originDir, mirrorDir := setupMirrors(t)
+
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpPatch,
+		Remotes: []PushRemote{
+			{Name: "offline", BestEffort: true},
+			{Name: "origin"},
+			{Name: "mirror", BestEffort: true},
+			{Name: "github", BestEffort: true},
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, result.Pushed)
+
+	// Required remotes are pushed first; missing ones are skipped
+	require.Len(t, result.Remotes, 3)
+	assert.Equal(t, RemoteResult{Remote: "origin", Pushed: true}, result.Remotes[0])
+	assert.Equal(t, "offline", result.Remotes[1].Remote)
+	assert.False(t, result.Remotes[1].Pushed)
+	assert.Contains(t, result.Remotes[1].Error, "remote unreachable")
+	assert.Equal(t,
+		RemoteResult{Remote: "mirror", BestEffort: true, Pushed: true}, result.Remotes[2])
+
+	require.Len(t, result.PushWarnings, 2)
+	assert.Equal(t, `remote "github" not found, not pushed`, result.PushWarnings[0])
+	assert.Contains(t, result.PushWarnings[1], "push to offline failed")
+
+	for _, dir := range []string{originDir, mirrorDir} {
+		remote, err := git.Open(dir)
+		require.NoError(t, err)
+		_, err = remote.FindTag("v1.0.1")
+		require.NoError(t, err, dir)
+	}
+}
+
+func TestExecute_RequiredRemoteFails(t *testing.T) {
+	repo, _, mirrorDir := setupMirrors(t)
+
+	result, err := Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpPatch,
+		Remotes: []PushRemote{
+			{Name: "offline"},
+			{Name: "mirror", BestEffort: true},
+		},
+	})
+	require.ErrorIs(t, err, git.ErrRemoteUnreachable)
+	assert.False(t, result.Pushed)
+
+	// The tag check fails before anything is tagged or pushed
+	assert.False(t, result.TagCreated)
+	_, err = repo.FindTag("v1.0.1")
+	assert.Error(t, err)
+	remote, err := git.Open(mirrorDir)
+	require.NoError(t, err)
+	_, err = remote.FindTag("v1.0.1")
+	assert.Error(t, err)
+
+	// A listed required remote must exist
+	_, err = Execute(context.Background(), Request{
+		Repository: repo,
+		BumpType:   version.BumpMinor,
+		Remotes:    []PushRemote{{Name: "github"}},
+	})
+	assert.ErrorContains(t, err, `remote "github" not found`)
+}
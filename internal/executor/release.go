@@ -0,0 +1,62 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+// CheckVersionFile fails early if the version file cannot be committed,
+// because the repository has no worktree
+func CheckVersionFile(repo *git.Repository, file VersionFile) error {
+	if file.Path != "" && repo.IsBare() {
+		return fmt.Errorf("%w: cannot update version file %s", git.ErrBareRepository, file.Path)
+	}
+	return nil
+}
+
+// TagRelease commits the version file, if one is set, and tags the release
+// commit, or target when nothing was committed. If the tag cannot be created
+// the release commit is dropped again, so the branch is not left with an
+// untagged release. Returns the tagged commit and whether a release commit
+// was made.
+func TagRelease(
+	ctx context.Context,
+	repo *git.Repository,
+	file VersionFile,
+	newVersion string,
+	tagName string,
+	message string,
+	target plumbing.Hash,
+) (plumbing.Hash, bool, error) {
+	if err := CheckVersionFile(repo, file); err != nil {
+		return plumbing.ZeroHash, false, err
+	}
+
+	base := target
+	committed := false
+	if file.Path != "" {
+		hash, ok, err := UpdateVersionFile(ctx, repo, file, newVersion, tagName)
+		if err != nil {
+			return plumbing.ZeroHash, false, fmt.Errorf("failed to update version file: %w", err)
+		}
+		if ok {
+			target, committed = hash, true
+		}
+	}
+
+	if err := repo.CreateTagAt(tagName, message, target); err != nil {
+		err = fmt.Errorf("failed to create tag: %w", err)
+		if committed {
+			if resetErr := repo.ResetBranch(ctx, base); resetErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to undo release commit: %w", resetErr))
+			}
+		}
+		return plumbing.ZeroHash, false, err
+	}
+	return target, committed, nil
+}
@@ -0,0 +1,121 @@
+package executor
+
+import (
+	"fmt"
+
+	"github.com/benny123tw/bumpkin/internal/conventional"
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+// SnapshotTagPrefix namespaces snapshot tags, e.g. snapshot/v1.5.0-feat-login.7+gabc1234,
+// so they never match the release prefix and are not taken for releases
+const SnapshotTagPrefix = "snapshot/"
+
+// SnapshotRequest contains the parameters for a snapshot version
+type SnapshotRequest struct {
+	Repository *git.Repository
+	Scheme     version.Scheme // Version scheme (default: the repository's scheme)
+	Branch     string         // Branch name for the prerelease identifier (default: current branch)
+	Prefix     string         // Tag prefix (default: "v")
+	CreateTag  bool           // If true, create a lightweight tag for the snapshot
+}
+
+// SnapshotResult contains a computed snapshot version
+type SnapshotResult struct {
+	PreviousVersion string
+	NextVersion     string // Recommended next release the snapshot is based on
+	Version         string
+	TagName         string
+	Branch          string
+	Commits         int // Commits since the previous tag
+	CommitHash      string
+	TagCreated      bool
+}
+
+// Snapshot computes a unique version for HEAD on a feature branch, such as
+// 1.5.0-feat-login.7+gabc1234. The base is the next version recommended by
+// conventional commits since the latest tag reachable from HEAD, bumped with
+// the request's scheme; the prerelease is the sanitized branch name and the
+// number of commits since that tag.
+func Snapshot(req SnapshotRequest) (*SnapshotResult, error) {
+	if req.Prefix == "" {
+		req.Prefix = "v"
+	}
+
+	branch := req.Branch
+	if branch == "" {
+		current, err := req.Repository.GetCurrentBranch()
+		if err != nil {
+			return nil, fmt.Errorf("%w; pass a branch name for the snapshot", err)
+		}
+		branch = current
+	}
+
+	head, err := req.Repository.GetHEAD()
+	if err != nil {
+		return nil, err
+	}
+
+	latestTag, err := req.Repository.LatestTagAt(req.Prefix, head)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest tag: %w", err)
+	}
+
+	prevVersion := version.Zero()
+	var commits []*git.Commit
+	if latestTag != nil && latestTag.Version != nil {
+		prevVersion = *latestTag.Version
+		commits, err = req.Repository.GetCommitsSinceTagAt(latestTag.Name, head)
+	} else {
+		commits, err = req.Repository.GetAllCommitsAt(head)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commits: %w", err)
+	}
+
+	messages := make([]string, len(commits))
+	for i, c := range commits {
+		messages[i] = c.Message
+	}
+	analysis := conventional.AnalyzeCommits(messages)
+
+	scheme := req.scheme()
+	next, err := scheme.Bump(prevVersion, scheme.Recommend(analysis.RecommendedBump))
+	if err != nil {
+		return nil, err
+	}
+	snapshot := version.Snapshot(next, branch, len(commits), head.String()[:7])
+	// The scheme formats the version number, keeping calver zero-padding
+	formatted := fmt.Sprintf("%s-%s+%s",
+		scheme.Format(version.Version{Major: next.Major, Minor: next.Minor, Patch: next.Patch}),
+		snapshot.Prerelease, snapshot.Metadata)
+	tagName := SnapshotTagPrefix + req.Prefix + formatted
+
+	result := &SnapshotResult{
+		PreviousVersion: scheme.Format(prevVersion),
+		NextVersion:     scheme.Format(next),
+		Version:         formatted,
+		TagName:         tagName,
+		Branch:          branch,
+		Commits:         len(commits),
+		CommitHash:      head.String(),
+	}
+
+	if req.CreateTag {
+		if err := req.Repository.CreateLightweightTag(tagName, head); err != nil {
+			return result, err
+		}
+		result.TagCreated = true
+	}
+
+	return result, nil
+}
+
+// scheme returns the request's version scheme, defaulting to the repository's
+func (req SnapshotRequest) scheme() version.Scheme {
+	if req.Scheme != nil {
+		return req.Scheme
+	}
+	return req.Repository.Scheme()
+}
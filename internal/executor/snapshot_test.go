@@ -0,0 +1,132 @@
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+func TestSnapshot(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.4.2", "-m", "Release v1.4.2")
+	runGit(t, tmpDir, "checkout", "-b", "feat/login")
+	createCommit(t, tmpDir, "feat: login form")
+	createCommit(t, tmpDir, "fix: typo")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	result, err := Snapshot(SnapshotRequest{Repository: repo})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1.4.2", result.PreviousVersion)
+	assert.Equal(t, "1.5.0", result.NextVersion)
+	assert.Equal(t, "1.5.0-feat-login.2+g"+head.String()[:7], result.Version)
+	assert.Equal(t, "feat/login", result.Branch)
+	assert.Equal(t, 2, result.Commits)
+	assert.False(t, result.TagCreated)
+
+	_, err = repo.FindTag(result.TagName)
+	assert.Error(t, err)
+}
+
+func TestSnapshot_IgnoresTagsOnOtherBranches(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.4.2", "-m", "Release v1.4.2")
+	runGit(t, tmpDir, "checkout", "-b", "feat/login")
+	createCommit(t, tmpDir, "fix: typo")
+	runGit(t, tmpDir, "checkout", "-")
+	createCommit(t, tmpDir, "feat!: new major")
+	runGit(t, tmpDir, "tag", "-a", "v2.0.0", "-m", "Release v2.0.0")
+	runGit(t, tmpDir, "checkout", "feat/login")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	result, err := Snapshot(SnapshotRequest{Repository: repo})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1.4.2", result.PreviousVersion)
+	assert.Equal(t, "1.4.3", result.NextVersion)
+	assert.Equal(t, 1, result.Commits)
+}
+
+func TestSnapshot_CalVer(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v2024.01.0", "-m", "Release v2024.01.0")
+	createCommit(t, tmpDir, "feat: new feature")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	scheme, err := version.NewCalVer("YYYY.0M.MICRO")
+	require.NoError(t, err)
+	scheme.Now = func() time.Time { return time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC) }
+
+	result, err := Snapshot(SnapshotRequest{
+		Repository: repo,
+		Scheme:     scheme,
+		Branch:     "main",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "2024.03.0", result.NextVersion)
+	assert.Contains(t, result.Version, "2024.03.0-main.1+g")
+	assert.Equal(t, "snapshot/v"+result.Version, result.TagName)
+}
+
+func TestSnapshot_CreateTag(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "fix: bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	result, err := Snapshot(SnapshotRequest{
+		Repository: repo,
+		Branch:     "PR-42",
+		CreateTag:  true,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.TagCreated)
+
+	tag, err := repo.FindTag(result.TagName)
+	require.NoError(t, err)
+	assert.False(t, tag.IsAnnotated)
+	assert.Contains(t, tag.Name, "snapshot/v1.0.1-pr-42.1+g")
+
+	// Snapshot tags are not taken for releases
+	for _, prefix := range []string{"v", ""} {
+		latest, err := repo.LatestTag(prefix)
+		require.NoError(t, err)
+		require.NotNil(t, latest)
+		assert.Equal(t, "v1.0.0", latest.Name)
+	}
+}
+
+func TestSnapshot_DetachedHead(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "checkout", "--detach")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	_, err = Snapshot(SnapshotRequest{Repository: repo})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pass a branch name")
+}
@@ -0,0 +1,175 @@
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+// ErrVersionNotGreater is returned when a new version is not greater than the
+// latest version tag it follows
+var ErrVersionNotGreater = errors.New("version is not greater than the latest version")
+
+// ErrDuplicateVersion is returned when a tag already holds the new version,
+// possibly with different build metadata
+var ErrDuplicateVersion = errors.New("version is already tagged")
+
+// VersionCheck is the outcome of checking a new version against the tags
+type VersionCheck struct {
+	Violations []error  // Downgrades and duplicates; fatal unless forced
+	Warnings   []string // Gaps such as 1.2.0 straight to 1.5.0
+}
+
+// Err returns the violations joined into one error, or nil
+func (c VersionCheck) Err() error {
+	return errors.Join(c.Violations...)
+}
+
+// CheckVersion checks that next is greater than the latest version tag it
+// follows (latest may be nil), with prereleases in the repository's channel
+// order, and that no tag with the prefix holds the same version, ignoring
+// build metadata. A next version on an older release line, such as a 1.4.x
+// patch after 2.0.0, follows the latest tag of its own line instead. Gaps are
+// only reported for schemes with minor and major bumps, since calendar
+// versions skip dates by design.
+func CheckVersion(
+	repo *git.Repository,
+	scheme version.Scheme,
+	prefix string,
+	latest *git.Tag,
+	next version.Version,
+) (VersionCheck, error) {
+	var check VersionCheck
+
+	tags, err := repo.ListTags()
+	if err != nil {
+		return check, err
+	}
+
+	if scheme.Supports(version.BumpMinor) {
+		latest = lineTag(repo.Channels(), tags, prefix, latest, next)
+	}
+	if latest != nil && latest.Version != nil {
+		prev := *latest.Version
+		if repo.Channels().Compare(prev, next) >= 0 {
+			check.Violations = append(check.Violations, fmt.Errorf(
+				"%w: %s is not greater than %s",
+				ErrVersionNotGreater, scheme.Format(next), latest.Name,
+			))
+		} else if scheme.Supports(version.BumpMinor) {
+			if gap := versionGap(prev, next); gap != "" {
+				check.Warnings = append(check.Warnings, gap)
+			}
+		}
+	}
+
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag.Name, prefix) || tag.Version == nil {
+			continue
+		}
+		if tag.Version.SamePrecedence(next) {
+			check.Violations = append(check.Violations, fmt.Errorf(
+				"%w: %s has version %s", ErrDuplicateVersion, tag.Name, scheme.Format(*tag.Version),
+			))
+		}
+	}
+
+	return check, nil
+}
+
+// lineTag returns the tag next follows on its release line. Versions on the
+// latest tag's major.minor line or a newer one follow latest; versions on an
+// older line follow the latest tag with the same major and minor, or failing
+// that the same major. Without any tag on next's major line it is a
+// downgrade from latest.
+func lineTag(
+	channels version.Channels,
+	tags []*git.Tag,
+	prefix string,
+	latest *git.Tag,
+	next version.Version,
+) *git.Tag {
+	if latest == nil || latest.Version == nil {
+		return latest
+	}
+	if prev := *latest.Version; next.Major > prev.Major ||
+		next.Major == prev.Major && next.Minor >= prev.Minor {
+		return latest
+	}
+
+	var sameMinor, sameMajor *git.Tag
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag.Name, prefix) || tag.Version == nil ||
+			tag.Version.Major != next.Major {
+			continue
+		}
+		if sameMajor == nil || channels.Compare(*tag.Version, *sameMajor.Version) > 0 {
+			sameMajor = tag
+		}
+		if tag.Version.Minor == next.Minor &&
+			(sameMinor == nil || channels.Compare(*tag.Version, *sameMinor.Version) > 0) {
+			sameMinor = tag
+		}
+	}
+	switch {
+	case sameMinor != nil:
+		return sameMinor
+	case sameMajor != nil:
+		return sameMajor
+	default:
+		return latest
+	}
+}
+
+// versionGap describes how next skips versions after prev, or returns an
+// empty string when next is a single bump away
+func versionGap(prev, next version.Version) string {
+	core := func(v version.Version) version.Version {
+		return version.Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	}
+
+	var expected []version.Version
+	if prev.IsPrerelease() {
+		expected = append(expected, core(prev))
+	}
+	for _, t := range []version.BumpType{version.BumpPatch, version.BumpMinor, version.BumpMajor} {
+		if v, err := version.Bump(prev, t); err == nil {
+			expected = append(expected, v)
+		}
+	}
+
+	names := make([]string, len(expected))
+	for i, v := range expected {
+		if core(v).Equal(core(next)) {
+			return ""
+		}
+		names[i] = core(v).String()
+	}
+
+	return fmt.Sprintf(
+		"%s skips versions after %s (expected %s)",
+		next, prev, strings.Join(names, ", "),
+	)
+}
+
+// ForceMessage appends the overridden violations and the reason for forcing
+// them to a tag annotation
+func ForceMessage(message string, violations []error, reason string) string {
+	if len(violations) == 0 {
+		return message
+	}
+
+	var sb strings.Builder
+	sb.WriteString(message)
+	sb.WriteString("\n")
+	for _, v := range violations {
+		fmt.Fprintf(&sb, "\nForced: %s", v)
+	}
+	if reason != "" {
+		fmt.Fprintf(&sb, "\nReason: %s", reason)
+	}
+	return sb.String()
+}
@@ -0,0 +1,181 @@
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+func TestExecute_Downgrade(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v3.2.0", "-m", "Release v3.2.0")
+	createCommit(t, tmpDir, "fix: bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	_, err = Execute(context.Background(), Request{
+		Repository:    repo,
+		BumpType:      version.BumpCustom,
+		CustomVersion: "1.0.0",
+		Prefix:        "v",
+		NoPush:        true,
+	})
+
+	require.ErrorIs(t, err, ErrVersionNotGreater)
+	assert.Contains(t, err.Error(), "1.0.0 is not greater than v3.2.0")
+}
+
+func TestExecute_MaintenanceRelease(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.4.2", "-m", "Release v1.4.2")
+	createCommit(t, tmpDir, "feat!: new major")
+	runGit(t, tmpDir, "tag", "-a", "v2.0.0", "-m", "Release v2.0.0")
+	createCommit(t, tmpDir, "fix: bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	release := func(custom string) (*Result, error) {
+		return Execute(context.Background(), Request{
+			Repository:    repo,
+			BumpType:      version.BumpCustom,
+			CustomVersion: custom,
+			Prefix:        "v",
+			DryRun:        true,
+		})
+	}
+
+	// A patch on the 1.4 line follows v1.4.2, not v2.0.0
+	result, err := release("1.4.3")
+	require.NoError(t, err)
+	assert.Empty(t, result.Warnings)
+
+	result, err = release("1.4.5")
+	require.NoError(t, err)
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0], "skips versions after 1.4.2")
+
+	// An older line of the same major follows its latest tag
+	_, err = release("1.3.0")
+	require.ErrorIs(t, err, ErrVersionNotGreater)
+	assert.Contains(t, err.Error(), "1.3.0 is not greater than v1.4.2")
+}
+
+func TestExecute_DuplicateMetadata(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.2.0+build.1", "-m", "Release v1.2.0+build.1")
+	createCommit(t, tmpDir, "fix: bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	_, err = Execute(context.Background(), Request{
+		Repository:    repo,
+		BumpType:      version.BumpCustom,
+		CustomVersion: "1.2.0+build.2",
+		Prefix:        "v",
+		NoPush:        true,
+		DryRun:        true,
+	})
+
+	require.ErrorIs(t, err, ErrDuplicateVersion)
+	assert.Contains(t, err.Error(), "v1.2.0+build.1")
+}
+
+func TestExecute_ForceDowngrade(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v3.2.0", "-m", "Release v3.2.0")
+	createCommit(t, tmpDir, "fix: bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	result, err := Execute(context.Background(), Request{
+		Repository:    repo,
+		BumpType:      version.BumpCustom,
+		CustomVersion: "1.0.0",
+		Force:         true,
+		ForceReason:   "re-release the 1.x line",
+		Prefix:        "v",
+		NoPush:        true,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Forced, 1)
+	assert.Contains(t, result.Forced[0], "not greater than v3.2.0")
+
+	tag, err := repo.FindTag("v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		"Release v1.0.0\n\n"+
+			"Forced: version is not greater than the latest version: "+
+			"1.0.0 is not greater than v3.2.0\n"+
+			"Reason: re-release the 1.x line",
+		tag.Message,
+	)
+}
+
+func TestExecute_GapWarning(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "tag", "-a", "v1.2.0", "-m", "Release v1.2.0")
+	createCommit(t, tmpDir, "fix: bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+
+	result, err := Execute(context.Background(), Request{
+		Repository:    repo,
+		BumpType:      version.BumpCustom,
+		CustomVersion: "1.5.0",
+		Prefix:        "v",
+		DryRun:        true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		[]string{"1.5.0 skips versions after 1.2.0 (expected 1.2.1, 1.3.0, 2.0.0)"},
+		result.Warnings,
+	)
+	assert.Empty(t, result.Forced)
+}
+
+func TestVersionGap(t *testing.T) {
+	tests := []struct {
+		prev string
+		next string
+		gap  bool
+	}{
+		{"1.2.0", "1.2.1", false},
+		{"1.2.0", "1.3.0", false},
+		{"1.2.0", "2.0.0", false},
+		{"1.2.0", "1.3.0-rc.0", false},
+		{"1.3.0-rc.1", "1.3.0", false},
+		{"1.3.0-rc.1", "1.3.0-rc.2", false},
+		{"1.2.0", "1.5.0", true},
+		{"1.2.0", "1.2.3", true},
+		{"1.2.0", "3.0.0", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prev+"->"+tt.next, func(t *testing.T) {
+			prev, err := version.Parse(tt.prev)
+			require.NoError(t, err)
+			next, err := version.Parse(tt.next)
+			require.NoError(t, err)
+			assert.Equal(t, tt.gap, versionGap(prev, next) != "")
+		})
+	}
+}
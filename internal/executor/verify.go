@@ -0,0 +1,95 @@
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+// ErrUntrustedTags is returned by the pre-flight check when release tags
+// are unsigned or not signed by a trusted key
+var ErrUntrustedTags = errors.New("release tags are not signed by trusted keys")
+
+// TagVerification is the outcome of verifying the signature of one tag
+type TagVerification struct {
+	Tag    string
+	Key    string // Fingerprint or key ID of the signing key, if signed
+	Signer string // Trusted identity that signed the tag, if verified
+	Err    error  // Why the tag is not verified; nil when it is
+}
+
+// VerifyTags verifies the signatures of the tags in order
+func VerifyTags(repo *git.Repository, tags []*git.Tag, verifier git.Verifier) []TagVerification {
+	results := make([]TagVerification, 0, len(tags))
+	for _, tag := range tags {
+		signer, err := repo.VerifyTag(tag.Name, verifier)
+		results = append(results, TagVerification{
+			Tag:    tag.Name,
+			Key:    tag.SignerKey,
+			Signer: signer,
+			Err:    err,
+		})
+	}
+	return results
+}
+
+// VerifyChain verifies the release tags with the prefix that are reachable
+// from hash and returns the tags that failed
+func VerifyChain(
+	repo *git.Repository,
+	prefix string,
+	hash plumbing.Hash,
+	verifier git.Verifier,
+) ([]TagVerification, error) {
+	chain, err := repo.TagsAt(prefix, hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list release tags: %w", err)
+	}
+
+	var failed []TagVerification
+	for _, result := range VerifyTags(repo, chain, verifier) {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed, nil
+}
+
+// CheckChain verifies the release tags reachable from hash before a release.
+// Failed verifications abort with an ErrUntrustedTags error when required
+// is set, and are otherwise returned as warnings.
+func CheckChain(
+	repo *git.Repository,
+	prefix string,
+	hash plumbing.Hash,
+	verifier git.Verifier,
+	required bool,
+) ([]string, error) {
+	failed, err := VerifyChain(repo, prefix, hash, verifier)
+	if err != nil {
+		return nil, err
+	}
+	if len(failed) > 0 && required {
+		return nil, untrustedTagsError(failed)
+	}
+	return verificationWarnings(failed), nil
+}
+
+// verificationWarnings describes failed verifications, one per tag
+func verificationWarnings(failed []TagVerification) []string {
+	warnings := make([]string, 0, len(failed))
+	for _, result := range failed {
+		warnings = append(warnings, fmt.Sprintf("%s: %v", result.Tag, result.Err))
+	}
+	return warnings
+}
+
+// untrustedTagsError joins failed verifications into an ErrUntrustedTags error
+func untrustedTagsError(failed []TagVerification) error {
+	return fmt.Errorf("%w:\n  %s", ErrUntrustedTags,
+		strings.Join(verificationWarnings(failed), "\n  "))
+}
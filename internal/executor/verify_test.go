@@ -0,0 +1,42 @@
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+func TestExecute_VerifyUnsignedTags(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir)
+	createCommit(t, tmpDir, "fix: bug")
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+	verifier, err := git.NewVerifier([]byte{}, nil)
+	require.NoError(t, err)
+
+	req := Request{
+		Repository: repo,
+		BumpType:   version.BumpPatch,
+		Prefix:     "v",
+		DryRun:     true,
+		Verifier:   verifier,
+	}
+
+	result, err := Execute(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0], "v1.0.0: tag is not signed")
+
+	req.RequireSigned = true
+	_, err = Execute(context.Background(), req)
+	require.ErrorIs(t, err, ErrUntrustedTags)
+	assert.Contains(t, err.Error(), "v1.0.0")
+}
@@ -0,0 +1,63 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+	"github.com/benny123tw/bumpkin/internal/version"
+	"github.com/benny123tw/bumpkin/internal/versionfile"
+)
+
+// VersionFile is a Go source file whose version string is updated and
+// committed before tagging
+type VersionFile struct {
+	Path    string // Relative to the repository root; empty disables the update
+	Var     string // String const or var to set
+	Package string // Package for a newly created file
+	Value   string // Template for the value, e.g. {{ pep440 .Version }} (default: the version)
+}
+
+// versionFileData is the data for the VersionFile value template
+type versionFileData struct {
+	Version string
+	TagName string
+}
+
+// UpdateVersionFile writes the version into the file and commits it with a
+// release message. Returns the new HEAD and whether a commit was made; if the
+// file already holds the version, nothing is committed.
+func UpdateVersionFile(
+	ctx context.Context,
+	repo *git.Repository,
+	file VersionFile,
+	newVersion string,
+	tagName string,
+) (plumbing.Hash, bool, error) {
+	value := newVersion
+	if file.Value != "" {
+		var err error
+		value, err = version.Render(file.Value, versionFileData{Version: newVersion, TagName: tagName})
+		if err != nil {
+			return plumbing.ZeroHash, false, err
+		}
+	}
+
+	path := filepath.Join(repo.Path, file.Path)
+	changed, err := versionfile.Update(path, file.Var, value, file.Package)
+	if err != nil {
+		return plumbing.ZeroHash, false, err
+	}
+	if !changed {
+		return plumbing.ZeroHash, false, nil
+	}
+
+	hash, err := repo.CommitFiles(ctx, fmt.Sprintf("chore(release): %s", tagName), file.Path)
+	if err != nil {
+		return plumbing.ZeroHash, false, err
+	}
+	return hash, true, nil
+}
@@ -0,0 +1,158 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// HasBranch checks if a local branch with the given name exists
+func (r *Repository) HasBranch(name string) (bool, error) {
+	_, err := r.repo.Reference(plumbing.NewBranchReferenceName(name), false)
+	if err == plumbing.ErrReferenceNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to look up branch %q: %w", name, err)
+	}
+	return true, nil
+}
+
+// CheckoutBranch switches the worktree to the given branch. If the branch does
+// not exist it is created at startPoint first.
+//
+// Like PushTag, this shells out to `git` so that a dirty worktree is refused
+// the same way a manual checkout would refuse it.
+func (r *Repository) CheckoutBranch(ctx context.Context, name, startPoint string) error {
+	exists, err := r.HasBranch(name)
+	if err != nil {
+		return err
+	}
+
+	args := []string{"checkout", name}
+	if !exists {
+		args = []string{"checkout", "-b", name, startPoint}
+	}
+
+	if out, err := r.runGit(ctx, args...); err != nil {
+		return fmt.Errorf("failed to check out branch %q: %w: %s", name, err, out)
+	}
+	return nil
+}
+
+// Checkout switches the worktree to a branch, or detaches HEAD at any other
+// revision. Like CheckoutBranch it refuses to overwrite uncommitted changes.
+func (r *Repository) Checkout(ctx context.Context, rev string) error {
+	if out, err := r.runGit(ctx, "checkout", rev); err != nil {
+		return fmt.Errorf("failed to check out %s: %w: %s", rev, err, out)
+	}
+	return nil
+}
+
+// CherryPick applies the given commits on top of the current branch, in order.
+// Each picked commit records its origin (-x). If a commit does not apply
+// cleanly the cherry-pick is aborted, the commits picked before it are dropped
+// again, and an error naming the commit is returned.
+func (r *Repository) CherryPick(ctx context.Context, hashes ...string) error {
+	start, err := r.GetHEAD()
+	if err != nil {
+		return err
+	}
+	for _, hash := range hashes {
+		out, err := r.runGit(ctx, "cherry-pick", "-x", "--allow-empty", hash)
+		if err != nil {
+			//nolint:errcheck // Best effort to leave the worktree clean
+			r.runGit(ctx, "cherry-pick", "--abort")
+			err = fmt.Errorf("failed to cherry-pick %s: %w: %s", shortHash(hash), err, out)
+			if resetErr := r.ResetBranch(ctx, start); resetErr != nil {
+				return errors.Join(err, resetErr)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// PushBranch pushes a local branch to the remote repository.
+// Shells out to `git push` for the same reasons as PushTag.
+func (r *Repository) PushBranch(ctx context.Context, branch, remoteName string) error {
+	hasRemote, err := r.HasRemote(remoteName)
+	if err != nil {
+		return err
+	}
+	if !hasRemote {
+		return fmt.Errorf("remote %q not found", remoteName)
+	}
+
+	refSpec := "refs/heads/" + branch + ":refs/heads/" + branch
+	if err := r.push(ctx, remoteName, refSpec); err != nil {
+		return fmt.Errorf("failed to push branch: %w", err)
+	}
+	return nil
+}
+
+// CommitFiles commits the given paths, and only those, with the message and
+// returns the new HEAD. Shells out so that commit hooks and signing config apply.
+func (r *Repository) CommitFiles(
+	ctx context.Context,
+	message string,
+	paths ...string,
+) (plumbing.Hash, error) {
+	addArgs := append([]string{"add", "--"}, paths...)
+	if out, err := r.runGit(ctx, addArgs...); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to stage files: %w: %s", err, out)
+	}
+
+	commitArgs := append([]string{"commit", "-m", message, "--"}, paths...)
+	if out, err := r.runGit(ctx, commitArgs...); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to commit: %w: %s", err, out)
+	}
+
+	return r.GetHEAD()
+}
+
+// ResetBranch moves the current branch back to hash, like `git reset --keep`:
+// files the dropped commits changed are restored, while other uncommitted
+// changes are kept. It fails rather than overwrite them.
+func (r *Repository) ResetBranch(ctx context.Context, hash plumbing.Hash) error {
+	if out, err := r.runGit(ctx, "reset", "--keep", hash.String()); err != nil {
+		return fmt.Errorf("failed to reset to %s: %w: %s", shortHash(hash.String()), err, out)
+	}
+	return nil
+}
+
+// IsDirty reports whether tracked files in the worktree have uncommitted
+// changes, matching `git describe --dirty`. A bare repository is never dirty.
+func (r *Repository) IsDirty(ctx context.Context) (bool, error) {
+	if r.IsBare() {
+		return false, nil
+	}
+	out, err := r.runGit(ctx, "status", "--porcelain", "--untracked-files=no")
+	if err != nil {
+		return false, fmt.Errorf("failed to get worktree status: %w: %s", err, out)
+	}
+	return out != "", nil
+}
+
+// runGit runs a non-interactive git command in the repository and returns
+// its trimmed combined output
+func (r *Repository) runGit(ctx context.Context, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = r.Path
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	out, err := cmd.CombinedOutput()
+	return strings.TrimSpace(string(out)), err
+}
+
+// shortHash abbreviates a full commit hash for messages
+func shortHash(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
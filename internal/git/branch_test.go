@@ -0,0 +1,129 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CheckoutBranch_CreatesFromStartPoint(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir, "v1.0.0", "Release v1.0.0")
+	createCommit(t, tmpDir, "feat: on main")
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+
+	exists, err := repo.HasBranch("hotfix/1.0.x")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, repo.CheckoutBranch(t.Context(), "hotfix/1.0.x", "v1.0.0"))
+
+	branch, err := repo.GetCurrentBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "hotfix/1.0.x", branch)
+
+	tag, err := repo.FindTag("v1.0.0")
+	require.NoError(t, err)
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+	assert.Equal(t, tag.CommitHash, head.String())
+
+	// Checking out an existing branch ignores the start point
+	runGit(t, tmpDir, "checkout", "-")
+	require.NoError(t, repo.CheckoutBranch(t.Context(), "hotfix/1.0.x", "HEAD"))
+	head, err = repo.GetHEAD()
+	require.NoError(t, err)
+	assert.Equal(t, tag.CommitHash, head.String())
+}
+
+func TestRepository_CherryPick(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir, "v1.0.0", "Release v1.0.0")
+	createCommit(t, tmpDir, "fix: critical bug")
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+
+	fix, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	require.NoError(t, repo.CheckoutBranch(t.Context(), "hotfix/1.0.x", "v1.0.0"))
+	require.NoError(t, repo.CherryPick(t.Context(), fix.String()))
+
+	commits, err := repo.GetCommitsSinceTag("v1.0.0")
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, "fix: critical bug", commits[0].Subject)
+	assert.Contains(t, commits[0].Message, "cherry picked from commit "+fix.String())
+}
+
+func TestRepository_CherryPick_ConflictAborts(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir, "v1.0.0", "Release v1.0.0")
+
+	readme := filepath.Join(tmpDir, "README.md")
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(readme, []byte("# Main\n"), 0o644))
+	runGit(t, tmpDir, "commit", "-am", "docs: main readme")
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+	pick, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	require.NoError(t, repo.CheckoutBranch(t.Context(), "hotfix/1.0.x", "v1.0.0"))
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(readme, []byte("# Hotfix\n"), 0o644))
+	runGit(t, tmpDir, "commit", "-am", "docs: hotfix readme")
+
+	err = repo.CherryPick(t.Context(), pick.String())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to cherry-pick "+pick.String()[:7])
+
+	// The worktree is left clean
+	_, statErr := os.Stat(filepath.Join(tmpDir, ".git", "CHERRY_PICK_HEAD"))
+	assert.True(t, os.IsNotExist(statErr))
+}
+
+func TestRepository_CherryPick_ConflictDropsEarlierPicks(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir, "v1.0.0", "Release v1.0.0")
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+
+	createCommit(t, tmpDir, "fix: clean change")
+	clean, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	readme := filepath.Join(tmpDir, "README.md")
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(readme, []byte("# Main\n"), 0o644))
+	runGit(t, tmpDir, "commit", "-am", "docs: main readme")
+	conflicting, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	require.NoError(t, repo.CheckoutBranch(t.Context(), "hotfix/1.0.x", "v1.0.0"))
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(readme, []byte("# Hotfix\n"), 0o644))
+	runGit(t, tmpDir, "commit", "-am", "docs: hotfix readme")
+	start, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	err = repo.CherryPick(t.Context(), clean.String(), conflicting.String())
+	require.Error(t, err)
+
+	// The clean pick is dropped again
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+	assert.Equal(t, start, head)
+}
@@ -19,11 +19,24 @@ type Commit struct {
 	Author      string
 	AuthorEmail string
 	Timestamp   time.Time
+	CommitTime  time.Time // Committer time, which can differ from Timestamp after a rebase
 }
 
 // GetCommitsSinceTag returns all commits between the given tag and HEAD
 // Commits are returned in reverse chronological order (newest first)
 func (r *Repository) GetCommitsSinceTag(tagName string) ([]*Commit, error) {
+	// Get HEAD
+	head, err := r.repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HEAD: %w", err)
+	}
+
+	return r.GetCommitsSinceTagAt(tagName, head.Hash())
+}
+
+// GetCommitsSinceTagAt returns all commits between the given tag and the given commit
+// Commits are returned in reverse chronological order (newest first)
+func (r *Repository) GetCommitsSinceTagAt(tagName string, hash plumbing.Hash) ([]*Commit, error) {
 	// Find the tag reference
 	tagRef, err := r.repo.Tag(tagName)
 	if err != nil {
@@ -33,20 +46,14 @@ func (r *Repository) GetCommitsSinceTag(tagName string) ([]*Commit, error) {
 	// Resolve tag to commit hash
 	tagCommitHash := r.resolveTagToCommit(tagRef)
 
-	// Get HEAD
-	head, err := r.repo.Head()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get HEAD: %w", err)
-	}
-
-	// If HEAD is the same as tag, no commits since tag
-	if head.Hash() == tagCommitHash {
+	// If the commit is the same as tag, no commits since tag
+	if hash == tagCommitHash {
 		return []*Commit{}, nil
 	}
 
-	// Get all commits from HEAD
+	// Get all commits from the given commit
 	commitIter, err := r.repo.Log(&git.LogOptions{
-		From:  head.Hash(),
+		From:  hash,
 		Order: git.LogOrderCommitterTime,
 	})
 	if err != nil {
@@ -79,8 +86,13 @@ func (r *Repository) GetAllCommits() ([]*Commit, error) {
 		return nil, fmt.Errorf("failed to get HEAD: %w", err)
 	}
 
+	return r.GetAllCommitsAt(head.Hash())
+}
+
+// GetAllCommitsAt returns all commits reachable from the given commit
+func (r *Repository) GetAllCommitsAt(hash plumbing.Hash) ([]*Commit, error) {
 	commitIter, err := r.repo.Log(&git.LogOptions{
-		From:  head.Hash(),
+		From:  hash,
 		Order: git.LogOrderCommitterTime,
 	})
 	if err != nil {
@@ -99,6 +111,50 @@ func (r *Repository) GetAllCommits() ([]*Commit, error) {
 	return commits, nil
 }
 
+// GetCommit returns the commit with the given hash
+func (r *Repository) GetCommit(hash plumbing.Hash) (*Commit, error) {
+	c, err := r.repo.CommitObject(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit: %w", err)
+	}
+	return commitFromObject(c), nil
+}
+
+// ResolveRevision resolves a revision such as a hash, a branch, a tag or an
+// expression like HEAD~2 to a commit hash
+func (r *Repository) ResolveRevision(rev string) (plumbing.Hash, error) {
+	hash, err := r.repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to resolve revision %q: %w", rev, err)
+	}
+
+	// Make sure the revision points to a commit
+	if _, err := r.repo.CommitObject(*hash); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("revision %q is not a commit: %w", rev, err)
+	}
+
+	return *hash, nil
+}
+
+// reachableCommits returns the set of commits reachable from the given commit
+func (r *Repository) reachableCommits(hash plumbing.Hash) (map[plumbing.Hash]bool, error) {
+	commitIter, err := r.repo.Log(&git.LogOptions{From: hash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit log: %w", err)
+	}
+
+	reachable := make(map[plumbing.Hash]bool)
+	err = commitIter.ForEach(func(c *object.Commit) error {
+		reachable[c.Hash] = true
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate commits: %w", err)
+	}
+
+	return reachable, nil
+}
+
 // resolveTagToCommit resolves a tag reference to its underlying commit hash
 func (r *Repository) resolveTagToCommit(tagRef *plumbing.Reference) plumbing.Hash {
 	// Try to get annotated tag object
@@ -125,6 +181,7 @@ func commitFromObject(c *object.Commit) *Commit {
 		Author:      c.Author.Name,
 		AuthorEmail: c.Author.Email,
 		Timestamp:   c.Author.When,
+		CommitTime:  c.Committer.When,
 	}
 }
 
@@ -0,0 +1,244 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+// ErrFetchRejected is returned when fetched tags would overwrite local tags
+// that point elsewhere
+var ErrFetchRejected = errors.New("fetched tags conflict with local tags")
+
+// FetchTags fetches the remote's tags, so releases tagged elsewhere are seen.
+// Floating tags with the prefix, such as v1 and v1.4, move with every release
+// and are updated to the remote's. With the git transport, other local tags
+// that point elsewhere are not overwritten and the fetch fails with
+// ErrFetchRejected; go-git updates them like floating tags. Other failures
+// wrap ErrRemoteUnreachable.
+func (r *Repository) FetchTags(ctx context.Context, remoteName, prefix string) error {
+	refSpec := "refs/tags/*:refs/tags/*"
+
+	if r.native != nil {
+		auth, err := r.nativeAuth(ctx, remoteName)
+		if err != nil {
+			return err
+		}
+		err = r.repo.FetchContext(ctx, &git.FetchOptions{
+			RemoteName: remoteName,
+			RefSpecs:   []config.RefSpec{config.RefSpec(refSpec)},
+			Auth:       auth,
+			Tags:       git.NoTags,
+		})
+		switch {
+		case err == nil, errors.Is(err, git.NoErrAlreadyUpToDate),
+			errors.Is(err, transport.ErrEmptyRemoteRepository):
+			return nil
+		case errors.Is(err, git.ErrForceNeeded):
+			return fmt.Errorf("%w: %w", ErrFetchRejected, err)
+		default:
+			return fmt.Errorf("%w: %w", ErrRemoteUnreachable, err)
+		}
+	}
+
+	floating, err := r.floatingTags(prefix)
+	if err != nil {
+		return err
+	}
+
+	// Floating tags are left out of the first fetch and forced in a second
+	// one, since a negative refspec also excludes them from a forced refspec
+	args := []string{"fetch", "--no-tags", remoteName, refSpec}
+	for _, name := range floating {
+		args = append(args, "^refs/tags/"+name)
+	}
+	if out, err := r.runGit(ctx, args...); err != nil {
+		return fetchError(err, out)
+	}
+	if len(floating) == 0 {
+		return nil
+	}
+
+	moved, err := r.remoteTagNames(ctx, remoteName, floating)
+	if err != nil {
+		return err
+	}
+	if len(moved) == 0 {
+		return nil
+	}
+	args = []string{"fetch", "--no-tags", remoteName}
+	for _, name := range moved {
+		args = append(args, "+refs/tags/"+name+":refs/tags/"+name)
+	}
+	if out, err := r.runGit(ctx, args...); err != nil {
+		return fetchError(err, out)
+	}
+	return nil
+}
+
+// floatingTags returns the names of the local floating tags with the prefix
+func (r *Repository) floatingTags(prefix string) ([]string, error) {
+	tags, err := r.ListTags()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, tag := range tags {
+		if v, ok := strings.CutPrefix(tag.Name, prefix); ok && version.IsFloating(v) {
+			names = append(names, tag.Name)
+		}
+	}
+	return names, nil
+}
+
+// remoteTagNames returns which of the given tags the remote has
+func (r *Repository) remoteTagNames(
+	ctx context.Context,
+	remoteName string,
+	names []string,
+) ([]string, error) {
+	args := []string{"ls-remote", "--tags", "--refs", remoteName}
+	for _, name := range names {
+		args = append(args, "refs/tags/"+name)
+	}
+	out, err := r.runGit(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w: %s", ErrRemoteUnreachable, err, out)
+	}
+
+	var found []string
+	for _, line := range strings.Split(out, "\n") {
+		_, ref, ok := strings.Cut(line, "\t")
+		if name, isTag := strings.CutPrefix(ref, "refs/tags/"); ok && isTag &&
+			slices.Contains(names, name) {
+			found = append(found, name)
+		}
+	}
+	return found, nil
+}
+
+// fetchError classifies a failed `git fetch` by its ref status lines, e.g.
+// " ! [rejected]  v1.2.0 -> v1.2.0  (would clobber existing tag)". Without
+// any rejected ref the remote was never read.
+func fetchError(err error, out string) error {
+	if strings.Contains(out, "[rejected]") {
+		return fmt.Errorf("%w: %s", ErrFetchRejected, out)
+	}
+	return fmt.Errorf("%w: %w: %s", ErrRemoteUnreachable, err, out)
+}
+
+// IsShallow reports whether the repository is a shallow clone
+func (r *Repository) IsShallow() (bool, error) {
+	shallow, err := r.repo.Storer.Shallow()
+	if err != nil {
+		return false, fmt.Errorf("failed to read shallow commits: %w", err)
+	}
+	return len(shallow) > 0, nil
+}
+
+// Deepen fetches up to commits more commits of history below the shallow
+// boundary, like `git fetch --deepen`. go-git cannot deepen a shallow clone
+// whose branch tips are unchanged, so this needs the git transport.
+func (r *Repository) Deepen(ctx context.Context, remoteName string, commits int) error {
+	if r.native != nil {
+		return fmt.Errorf("deepening a shallow clone needs the git push transport")
+	}
+	out, err := r.runGit(ctx, "fetch", "--no-tags", "--deepen="+strconv.Itoa(commits), remoteName)
+	if err != nil {
+		return fmt.Errorf("failed to deepen history: %w: %s", err, out)
+	}
+	return nil
+}
+
+// ReachableTag returns the version tag with the prefix on the nearest commit
+// of HEAD's local history that has one, or nil if there is none. Unlike a log
+// walk it stops at the shallow boundary, so in a shallow clone a tag below the
+// boundary is not reachable even if its commit was fetched.
+func (r *Repository) ReachableTag(prefix string) (*Tag, error) {
+	tags, err := r.ListTags()
+	if err != nil {
+		return nil, err
+	}
+	channels := r.Channels()
+	byCommit := make(map[plumbing.Hash]*Tag)
+	for _, tag := range r.releaseTags(tags) {
+		if !strings.HasPrefix(tag.Name, prefix) || tag.Version == nil {
+			continue
+		}
+		hash := plumbing.NewHash(tag.CommitHash)
+		if other := byCommit[hash]; other == nil ||
+			channels.Compare(*other.Version, *tag.Version) < 0 {
+			byCommit[hash] = tag
+		}
+	}
+	if len(byCommit) == 0 {
+		return nil, nil
+	}
+
+	head, err := r.GetHEAD()
+	if err != nil {
+		return nil, err
+	}
+	var found *Tag
+	err = r.walkLocal(head, func(h plumbing.Hash) bool {
+		found = byCommit[h]
+		return found == nil
+	})
+	return found, err
+}
+
+// walkLocal visits the commits reachable from hash without crossing the
+// shallow boundary, until visit returns false
+func (r *Repository) walkLocal(hash plumbing.Hash, visit func(plumbing.Hash) bool) error {
+	shallow, err := r.shallowCommits()
+	if err != nil {
+		return err
+	}
+	seen := map[plumbing.Hash]bool{hash: true}
+	queue := []plumbing.Hash{hash}
+	for len(queue) > 0 {
+		h := queue[0]
+		queue = queue[1:]
+		if !visit(h) {
+			return nil
+		}
+		if shallow[h] {
+			continue
+		}
+		c, err := r.repo.CommitObject(h)
+		if err != nil {
+			return fmt.Errorf("failed to read commit %s: %w", shortHash(h.String()), err)
+		}
+		for _, parent := range c.ParentHashes {
+			if !seen[parent] {
+				seen[parent] = true
+				queue = append(queue, parent)
+			}
+		}
+	}
+	return nil
+}
+
+// shallowCommits returns the commits at the shallow boundary, whose parents
+// are missing
+func (r *Repository) shallowCommits() (map[plumbing.Hash]bool, error) {
+	hashes, err := r.repo.Storer.Shallow()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read shallow commits: %w", err)
+	}
+	shallow := make(map[plumbing.Hash]bool, len(hashes))
+	for _, h := range hashes {
+		shallow[h] = true
+	}
+	return shallow, nil
+}
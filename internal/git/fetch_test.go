@@ -0,0 +1,115 @@
+package git
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// shallowClone returns a depth 1 clone of a repository with v1.0.0 tagged
+// below the given number of later commits
+func shallowClone(t *testing.T, later int) string {
+	t.Helper()
+	srcDir := t.TempDir()
+	initRealGitRepo(t, srcDir)
+	createTag(t, srcDir, "v1.0.0", "Release v1.0.0")
+	for i := range later {
+		runGit(t, srcDir, "commit", "--allow-empty", "-m", fmt.Sprintf("fix: bug %d", i))
+	}
+
+	dir := t.TempDir()
+	runGit(t, dir, "clone", "--depth", "1", "--no-tags", "file://"+srcDir, ".")
+	return dir
+}
+
+func TestRepository_FetchTags(t *testing.T) {
+	for name, native := range releaseTransports {
+		t.Run(name, func(t *testing.T) {
+			repo, _, remoteDir := setupReleaseRemote(t, native)
+
+			// Another checkout released v1.0.0
+			otherDir := cloneRemote(t, remoteDir)
+			createTag(t, otherDir, "v1.0.0", "Release v1.0.0")
+			runGit(t, otherDir, "push", "origin", "v1.0.0")
+
+			require.NoError(t, repo.FetchTags(t.Context(), "origin", "v"))
+			tag, err := repo.LatestTag("v")
+			require.NoError(t, err)
+			require.NotNil(t, tag)
+			assert.Equal(t, "v1.0.0", tag.Name)
+		})
+	}
+}
+
+func TestRepository_FetchTags_MovedFloatingTag(t *testing.T) {
+	for name, native := range releaseTransports {
+		t.Run(name, func(t *testing.T) {
+			repo, localDir, remoteDir := setupReleaseRemote(t, native)
+			createTag(t, localDir, "v1.0.0", "Release v1.0.0")
+			runGit(t, localDir, "tag", "v1")
+			runGit(t, localDir, "push", "origin", "v1.0.0", "v1")
+
+			// Another checkout released v1.1.0 and moved v1 along
+			otherDir := cloneRemote(t, remoteDir)
+			runGit(t, otherDir, "commit", "--allow-empty", "-m", "feat: more")
+			createTag(t, otherDir, "v1.1.0", "Release v1.1.0")
+			runGit(t, otherDir, "tag", "-f", "v1")
+			runGit(t, otherDir, "push", "-f", "origin", "HEAD", "v1.1.0", "v1")
+
+			require.NoError(t, repo.FetchTags(t.Context(), "origin", "v"))
+			latest, err := repo.LatestTag("v")
+			require.NoError(t, err)
+			require.NotNil(t, latest)
+			assert.Equal(t, "v1.1.0", latest.Name)
+			floating, err := repo.ResolveRevision("v1")
+			require.NoError(t, err)
+			assert.Equal(t, latest.CommitHash, floating.String())
+		})
+	}
+}
+
+func TestRepository_FetchTags_Rejected(t *testing.T) {
+	repo, localDir, remoteDir := setupReleaseRemote(t, nil)
+	createTag(t, localDir, "v1.0.0", "Release v1.0.0")
+	runGit(t, localDir, "push", "origin", "v1.0.0")
+
+	// The release tag is re-pointed on the remote
+	otherDir := cloneRemote(t, remoteDir)
+	runGit(t, otherDir, "commit", "--allow-empty", "-m", "fix: other")
+	runGit(t, otherDir, "tag", "-f", "-a", "v1.0.0", "-m", "Release v1.0.0")
+	runGit(t, otherDir, "push", "-f", "origin", "HEAD", "v1.0.0")
+
+	err := repo.FetchTags(t.Context(), "origin", "v")
+	require.ErrorIs(t, err, ErrFetchRejected)
+	assert.NotErrorIs(t, err, ErrRemoteUnreachable)
+}
+
+func TestRepository_Deepen(t *testing.T) {
+	dir := shallowClone(t, 5)
+	repo, err := Open(dir)
+	require.NoError(t, err)
+
+	shallow, err := repo.IsShallow()
+	require.NoError(t, err)
+	assert.True(t, shallow)
+
+	// The tag's commit is fetched, but not connected to HEAD
+	require.NoError(t, repo.FetchTags(t.Context(), "origin", "v"))
+	_, err = repo.FindTag("v1.0.0")
+	require.NoError(t, err)
+	tag, err := repo.ReachableTag("v")
+	require.NoError(t, err)
+	assert.Nil(t, tag)
+
+	require.NoError(t, repo.Deepen(t.Context(), "origin", 5))
+	tag, err = repo.ReachableTag("v")
+	require.NoError(t, err)
+	require.NotNil(t, tag)
+	assert.Equal(t, "v1.0.0", tag.Name)
+
+	// go-git cannot deepen a shallow clone
+	repo.SetNativeTransport(&NativeTransport{})
+	assert.ErrorContains(t, repo.Deepen(t.Context(), "origin", 5), "git push transport")
+}
@@ -3,9 +3,6 @@ package git
 import (
 	"context"
 	"fmt"
-	"os"
-	"os/exec"
-	"strings"
 
 	"github.com/go-git/go-git/v5/plumbing"
 )
@@ -16,8 +13,20 @@ import (
 // in-process Push so that the user's git config is honored — insteadOf
 // URL rewrites, http.sslVerify, http.proxy, credential helpers, and SSH
 // keys. go-git does not read any of those on its own, which breaks pushes
-// to enterprise hosts that rely on them.
+// to enterprise hosts that rely on them. Where there is no git binary,
+// SetNativeTransport pushes with go-git instead.
 func (r *Repository) PushTag(ctx context.Context, tagName, remoteName string) error {
+	return r.pushTagRef(ctx, tagName, remoteName, false)
+}
+
+// ForcePushTag pushes a tag to the remote repository, replacing the remote tag
+// if it points elsewhere. Only the given tag ref is forced.
+func (r *Repository) ForcePushTag(ctx context.Context, tagName, remoteName string) error {
+	return r.pushTagRef(ctx, tagName, remoteName, true)
+}
+
+// pushTagRef pushes a single tag ref, optionally forced with a + refspec
+func (r *Repository) pushTagRef(ctx context.Context, tagName, remoteName string, force bool) error {
 	hasRemote, err := r.HasRemote(remoteName)
 	if err != nil {
 		return err
@@ -31,23 +40,17 @@ func (r *Repository) PushTag(ctx context.Context, tagName, remoteName string) er
 	}
 
 	refSpec := "refs/tags/" + tagName + ":refs/tags/" + tagName
-	cmd := exec.CommandContext(ctx, "git", "push", remoteName, refSpec)
-	cmd.Dir = r.Path
-	// Force non-interactive: rely on credential helpers / SSH agent / tokens.
-	// Without this, git can hang on a credential prompt when run from the TUI.
-	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
-	out, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf(
-			"failed to push tag: %w: %s",
-			err, strings.TrimSpace(string(out)),
-		)
+	if force {
+		refSpec = "+" + refSpec
+	}
+	if err := r.push(ctx, remoteName, refSpec); err != nil {
+		return fmt.Errorf("failed to push tag: %w", err)
 	}
 	return nil
 }
 
 // PushAllTags pushes all tags to the remote repository.
-// Shells out to `git push --tags` for the same reasons as PushTag.
+// Shells out to `git push` for the same reasons as PushTag.
 func (r *Repository) PushAllTags(ctx context.Context, remoteName string) error {
 	hasRemote, err := r.HasRemote(remoteName)
 	if err != nil {
@@ -57,15 +60,22 @@ func (r *Repository) PushAllTags(ctx context.Context, remoteName string) error {
 		return fmt.Errorf("remote %q not found", remoteName)
 	}
 
-	cmd := exec.CommandContext(ctx, "git", "push", remoteName, "--tags")
-	cmd.Dir = r.Path
-	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
-	out, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf(
-			"failed to push tags: %w: %s",
-			err, strings.TrimSpace(string(out)),
-		)
+	if err := r.push(ctx, remoteName, "refs/tags/*:refs/tags/*"); err != nil {
+		return fmt.Errorf("failed to push tags: %w", err)
+	}
+	return nil
+}
+
+// push pushes refspecs to the remote, with go-git when a native transport is
+// set and with the git binary otherwise
+func (r *Repository) push(ctx context.Context, remoteName string, refSpecs ...string) error {
+	if r.native != nil {
+		return r.pushNative(ctx, remoteName, refSpecs, false)
+	}
+
+	args := append([]string{"push", remoteName}, refSpecs...)
+	if out, err := r.runGit(ctx, args...); err != nil {
+		return fmt.Errorf("%w: %s", err, out)
 	}
 	return nil
 }
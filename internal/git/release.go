@@ -0,0 +1,156 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// ErrRemoteTagExists is returned when the remote already has the release tag,
+// usually because another release took the version first
+var ErrRemoteTagExists = errors.New("tag already exists on the remote")
+
+// ErrPushRejected is returned when the remote refuses the release push, for
+// example because the branch moved on or a hook declined it
+var ErrPushRejected = errors.New("push rejected by the remote")
+
+// ErrRemoteUnreachable is returned when the remote cannot be reached or
+// read, so nothing is known about its refs
+var ErrRemoteUnreachable = errors.New("remote unreachable")
+
+// RemoteTagHash returns the hash the tag has on the remote, like
+// `git ls-remote`, or the zero hash if the remote has no such tag. For
+// annotated tags this is the tag object, not the commit.
+func (r *Repository) RemoteTagHash(
+	ctx context.Context,
+	remoteName, tagName string,
+) (plumbing.Hash, error) {
+	refName := "refs/tags/" + tagName
+
+	if r.native != nil {
+		refs, err := r.listNative(ctx, remoteName, git.IgnorePeeled)
+		if err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("%w: %w", ErrRemoteUnreachable, err)
+		}
+		for _, ref := range refs {
+			if ref.Name().String() == refName {
+				return ref.Hash(), nil
+			}
+		}
+		return plumbing.ZeroHash, nil
+	}
+
+	out, err := r.runGit(ctx, "ls-remote", remoteName, refName)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("%w: %w: %s", ErrRemoteUnreachable, err, out)
+	}
+	for _, line := range strings.Split(out, "\n") {
+		hash, name, ok := strings.Cut(line, "\t")
+		if ok && name == refName {
+			return plumbing.NewHash(hash), nil
+		}
+	}
+	return plumbing.ZeroHash, nil
+}
+
+// CheckRemoteTag returns ErrRemoteTagExists if the remote has the tag,
+// unless it is the same tag as the local one, e.g. from an earlier push
+func (r *Repository) CheckRemoteTag(ctx context.Context, remoteName, tagName string) error {
+	remoteHash, err := r.RemoteTagHash(ctx, remoteName, tagName)
+	if err != nil {
+		return err
+	}
+	if remoteHash.IsZero() {
+		return nil
+	}
+	if local, err := r.repo.Tag(tagName); err == nil && local.Hash() == remoteHash {
+		return nil
+	}
+	return fmt.Errorf("%w: %s on %s", ErrRemoteTagExists, tagName, remoteName)
+}
+
+// PushRelease pushes the release tag, and the branch with the release commit
+// unless branch is empty, in one atomic push: the remote takes both refs or
+// neither, so a rejected tag never leaves an untagged release commit behind.
+// The remote is checked for the tag first. Errors wrap ErrRemoteTagExists,
+// ErrPushRejected or ErrRemoteUnreachable.
+func (r *Repository) PushRelease(ctx context.Context, remoteName, branch, tagName string) error {
+	hasRemote, err := r.HasRemote(remoteName)
+	if err != nil {
+		return err
+	}
+	if !hasRemote {
+		return fmt.Errorf("remote %q not found", remoteName)
+	}
+	if _, err := r.repo.Tag(tagName); err != nil {
+		return fmt.Errorf("tag %q not found: %w", tagName, err)
+	}
+
+	if err := r.CheckRemoteTag(ctx, remoteName, tagName); err != nil {
+		return err
+	}
+
+	var refSpecs []string
+	if branch != "" {
+		refSpecs = append(refSpecs, "refs/heads/"+branch+":refs/heads/"+branch)
+	}
+	refSpecs = append(refSpecs, "refs/tags/"+tagName+":refs/tags/"+tagName)
+
+	if r.native != nil {
+		if err := r.pushNative(ctx, remoteName, refSpecs, true); err != nil {
+			return nativePushError(remoteName, err)
+		}
+		return nil
+	}
+
+	args := append([]string{"push", "--atomic", "--porcelain", remoteName}, refSpecs...)
+	if out, err := r.runGit(ctx, args...); err != nil {
+		return pushError(remoteName, err, out)
+	}
+	return nil
+}
+
+// pushError classifies a failed `git push --porcelain` by its ref status
+// lines, e.g. "!\trefs/tags/v1.2.0:refs/tags/v1.2.0\t[rejected] (already exists)".
+// Without any rejected ref the remote was never reached.
+func pushError(remoteName string, err error, out string) error {
+	rejected := false
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) < 3 || fields[0] != "!" {
+			continue
+		}
+		rejected = true
+		_, dst, _ := strings.Cut(fields[1], ":")
+		if tag, ok := strings.CutPrefix(dst, "refs/tags/"); ok &&
+			strings.Contains(fields[2], "already exists") {
+			return fmt.Errorf("%w: %s on %s", ErrRemoteTagExists, tag, remoteName)
+		}
+	}
+	if rejected {
+		return fmt.Errorf("%w: %s", ErrPushRejected, out)
+	}
+	return fmt.Errorf("%w: %w: %s", ErrRemoteUnreachable, err, out)
+}
+
+// nativePushError classifies a failed go-git push. go-git refuses
+// non-fast-forward updates itself and reports refs the remote declined as
+// "command error on <ref>"; anything else is a transport failure.
+func nativePushError(remoteName string, err error) error {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "non-fast-forward update: refs/tags/"),
+		strings.Contains(msg, "command error on refs/tags/") &&
+			strings.Contains(msg, "already exists"):
+		return fmt.Errorf("%w on %s: %w", ErrRemoteTagExists, remoteName, err)
+	case strings.Contains(msg, "non-fast-forward update"),
+		strings.Contains(msg, "command error on"):
+		return fmt.Errorf("%w: %w", ErrPushRejected, err)
+	default:
+		return fmt.Errorf("%w: %w", ErrRemoteUnreachable, err)
+	}
+}
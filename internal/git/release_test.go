@@ -0,0 +1,180 @@
+package git
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// releaseTransports are the push transports PushRelease is tested with
+var releaseTransports = map[string]*NativeTransport{
+	"git":    nil,
+	"native": {},
+}
+
+// setupReleaseRemote returns a local repository whose branch is pushed to a
+// bare origin, and the origin's path
+func setupReleaseRemote(t *testing.T, native *NativeTransport) (*Repository, string, string) {
+	t.Helper()
+	remoteDir := t.TempDir()
+	runGit(t, remoteDir, "init", "--bare")
+
+	localDir := t.TempDir()
+	initRealGitRepo(t, localDir)
+	runGit(t, localDir, "remote", "add", "origin", remoteDir)
+	runGit(t, localDir, "push", "origin", getCurrentBranch(t, localDir))
+
+	repo, err := Open(localDir)
+	require.NoError(t, err)
+	repo.SetNativeTransport(native)
+	return repo, localDir, remoteDir
+}
+
+// commitFile commits a new file in dir
+func commitFile(t *testing.T, dir, name string) {
+	t.Helper()
+	//nolint:gosec // test file
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name+"\n"), 0o644))
+	runGit(t, dir, "add", name)
+	runGit(t, dir, "commit", "-m", "feat: add "+name)
+}
+
+// cloneRemote clones the bare remote into a new directory
+func cloneRemote(t *testing.T, remoteDir string) string {
+	t.Helper()
+	dir := t.TempDir()
+	runGit(t, dir, "clone", remoteDir, ".")
+	runGit(t, dir, "config", "user.email", "other@example.com")
+	runGit(t, dir, "config", "user.name", "Other User")
+	return dir
+}
+
+func TestRepository_PushRelease(t *testing.T) {
+	for name, native := range releaseTransports {
+		t.Run(name, func(t *testing.T) {
+			repo, localDir, remoteDir := setupReleaseRemote(t, native)
+			branch := getCurrentBranch(t, localDir)
+
+			commitFile(t, localDir, "CHANGES.md")
+			require.NoError(t, repo.CreateTag("v1.0.0", "Release v1.0.0"))
+			require.NoError(t, repo.PushRelease(t.Context(), "origin", branch, "v1.0.0"))
+
+			// The same tag is already on the remote, which is not an error
+			require.NoError(t, repo.PushRelease(t.Context(), "origin", branch, "v1.0.0"))
+
+			remote, err := Open(remoteDir)
+			require.NoError(t, err)
+			tag, err := remote.FindTag("v1.0.0")
+			require.NoError(t, err)
+			head, err := repo.GetHEAD()
+			require.NoError(t, err)
+			assert.Equal(t, head.String(), tag.CommitHash)
+			branchHash, err := remote.ResolveRevision(branch)
+			require.NoError(t, err)
+			assert.Equal(t, head, branchHash)
+		})
+	}
+}
+
+func TestRepository_PushRelease_TagTaken(t *testing.T) {
+	for name, native := range releaseTransports {
+		t.Run(name, func(t *testing.T) {
+			repo, localDir, remoteDir := setupReleaseRemote(t, native)
+			branch := getCurrentBranch(t, localDir)
+
+			// Another release took v1.0.0 first
+			otherDir := cloneRemote(t, remoteDir)
+			createTag(t, otherDir, "v1.0.0", "Release v1.0.0")
+			runGit(t, otherDir, "push", "origin", "v1.0.0")
+
+			commitFile(t, localDir, "CHANGES.md")
+			require.NoError(t, repo.CreateTag("v1.0.0", "Release v1.0.0"))
+
+			err := repo.CheckRemoteTag(t.Context(), "origin", "v1.0.0")
+			require.ErrorIs(t, err, ErrRemoteTagExists)
+
+			err = repo.PushRelease(t.Context(), "origin", branch, "v1.0.0")
+			require.ErrorIs(t, err, ErrRemoteTagExists)
+
+			// The release commit was not pushed without its tag
+			remote, err := Open(remoteDir)
+			require.NoError(t, err)
+			branchHash, err := remote.ResolveRevision(branch)
+			require.NoError(t, err)
+			head, err := repo.GetHEAD()
+			require.NoError(t, err)
+			assert.NotEqual(t, head, branchHash)
+		})
+	}
+}
+
+func TestRepository_PushRelease_BranchRejected(t *testing.T) {
+	for name, native := range releaseTransports {
+		t.Run(name, func(t *testing.T) {
+			repo, localDir, remoteDir := setupReleaseRemote(t, native)
+			branch := getCurrentBranch(t, localDir)
+
+			// The branch moved on since the release commit was made
+			otherDir := cloneRemote(t, remoteDir)
+			commitFile(t, otherDir, "OTHER.md")
+			runGit(t, otherDir, "push", "origin", branch)
+
+			commitFile(t, localDir, "CHANGES.md")
+			require.NoError(t, repo.CreateTag("v1.0.0", "Release v1.0.0"))
+
+			err := repo.PushRelease(t.Context(), "origin", branch, "v1.0.0")
+			require.ErrorIs(t, err, ErrPushRejected)
+
+			// Atomic: the tag was not pushed either
+			hash, err := repo.RemoteTagHash(t.Context(), "origin", "v1.0.0")
+			require.NoError(t, err)
+			assert.True(t, hash.IsZero())
+		})
+	}
+}
+
+func TestRepository_PushRelease_Unreachable(t *testing.T) {
+	for name, native := range releaseTransports {
+		t.Run(name, func(t *testing.T) {
+			localDir := t.TempDir()
+			initRealGitRepo(t, localDir)
+			missing := filepath.Join(t.TempDir(), "missing.git")
+			runGit(t, localDir, "remote", "add", "origin", missing)
+
+			repo, err := Open(localDir)
+			require.NoError(t, err)
+			repo.SetNativeTransport(native)
+			require.NoError(t, repo.CreateTag("v1.0.0", "Release v1.0.0"))
+
+			err = repo.PushRelease(t.Context(), "origin", "", "v1.0.0")
+			require.ErrorIs(t, err, ErrRemoteUnreachable)
+			assert.NotErrorIs(t, err, ErrPushRejected)
+		})
+	}
+}
+
+func TestPushError(t *testing.T) {
+	failed := errors.New("exit status 1")
+
+	// The tag was taken between the ls-remote check and the push
+	out := "To /srv/git/release.git\n" +
+		"!\tHEAD:refs/heads/main\t[rejected] (atomic push failed)\n" +
+		"!\trefs/tags/v1.2.0:refs/tags/v1.2.0\t[rejected] (already exists)\n" +
+		"Done"
+	err := pushError("origin", failed, out)
+	require.ErrorIs(t, err, ErrRemoteTagExists)
+	assert.ErrorContains(t, err, "v1.2.0 on origin")
+
+	out = "To /srv/git/release.git\n" +
+		"!\trefs/heads/main:refs/heads/main\t[rejected] (fetch first)\n" +
+		"!\trefs/tags/v1.2.0:refs/tags/v1.2.0\t[rejected] (atomic push failed)\n" +
+		"Done"
+	assert.ErrorIs(t, pushError("origin", failed, out), ErrPushRejected)
+
+	out = "fatal: unable to access 'https://example.com/r.git/': Could not resolve host"
+	assert.ErrorIs(t, pushError("origin", failed, out), ErrRemoteUnreachable)
+}
@@ -0,0 +1,78 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// OpenRemote returns a repository for the remote at url, named origin, that
+// is never cloned: it only lists the remote's tags, with RemoteTags and
+// LatestRemoteTag. The scheme, tag type and transport setters apply as usual.
+func OpenRemote(url string) (*Repository, error) {
+	repo, err := git.Init(memory.NewStorage(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repository: %w", err)
+	}
+	_, err = repo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{url}})
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote URL: %w", err)
+	}
+	return &Repository{repo: repo}, nil
+}
+
+// RemoteTags lists the remote's tags with the ls-remote protocol through
+// go-git, whatever the push transport. Annotated tags are recognised by their
+// peeled refs (v1.0.0^{}), which give the tagged commit; messages, taggers
+// and signatures are in the tag objects and stay empty. Failures wrap
+// ErrRemoteUnreachable.
+func (r *Repository) RemoteTags(ctx context.Context, remoteName string) ([]*Tag, error) {
+	refs, err := r.listNative(ctx, remoteName, git.AppendPeeled)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRemoteUnreachable, err)
+	}
+
+	byName := make(map[string]*Tag)
+	peeled := make(map[string]string)
+	for _, ref := range refs {
+		name, ok := strings.CutPrefix(ref.Name().String(), "refs/tags/")
+		if !ok {
+			continue
+		}
+		if target, ok := strings.CutSuffix(name, "^{}"); ok {
+			peeled[target] = ref.Hash().String()
+			continue
+		}
+		byName[name] = &Tag{
+			Name:       name,
+			CommitHash: ref.Hash().String(),
+			Version:    r.tagVersion(name),
+		}
+	}
+
+	tags := make([]*Tag, 0, len(byName))
+	for name, tag := range byName {
+		if commit, ok := peeled[name]; ok {
+			tag.CommitHash = commit
+			tag.IsAnnotated = true
+		}
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+	return tags, nil
+}
+
+// LatestRemoteTag returns the latest release tag with the given prefix on the
+// remote, picked like LatestTag, or nil if there is none
+func (r *Repository) LatestRemoteTag(ctx context.Context, remoteName, prefix string) (*Tag, error) {
+	tags, err := r.RemoteTags(ctx, remoteName)
+	if err != nil {
+		return nil, err
+	}
+	return latestTag(r.releaseTags(tags), prefix, r.Channels()), nil
+}
@@ -0,0 +1,81 @@
+package git
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTaggedRemote returns a bare repository with annotated, lightweight,
+// prerelease, floating and non-version tags, and the commit of v1.0.0
+func setupTaggedRemote(t *testing.T) (string, string) {
+	t.Helper()
+	remoteDir := t.TempDir()
+	runGit(t, remoteDir, "init", "--bare")
+
+	localDir := t.TempDir()
+	initRealGitRepo(t, localDir)
+	createTag(t, localDir, "v1.0.0", "Release v1.0.0")
+	runGit(t, localDir, "commit", "--allow-empty", "-m", "feat: new feature")
+	runGit(t, localDir, "tag", "v1.1.0")
+	runGit(t, localDir, "tag", "v1")
+	runGit(t, localDir, "tag", "nightly")
+	runGit(t, localDir, "commit", "--allow-empty", "-m", "feat!: breaking change")
+	createTag(t, localDir, "v2.0.0-rc.1", "Release v2.0.0-rc.1")
+	runGit(t, localDir, "push", remoteDir, "--tags")
+
+	repo, err := Open(localDir)
+	require.NoError(t, err)
+	tag, err := repo.FindTag("v1.0.0")
+	require.NoError(t, err)
+	return remoteDir, tag.CommitHash
+}
+
+func TestRepository_RemoteTags(t *testing.T) {
+	remoteDir, commit := setupTaggedRemote(t)
+
+	repo, err := OpenRemote(remoteDir)
+	require.NoError(t, err)
+	tags, err := repo.RemoteTags(t.Context(), "origin")
+	require.NoError(t, err)
+
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	assert.Equal(t, []string{"nightly", "v1", "v1.0.0", "v1.1.0", "v2.0.0-rc.1"}, names)
+
+	// Annotated tags are peeled to their commit
+	assert.True(t, tags[2].IsAnnotated)
+	assert.Equal(t, commit, tags[2].CommitHash)
+	assert.False(t, tags[3].IsAnnotated)
+	assert.Nil(t, tags[1].Version)
+
+	latest, err := repo.LatestRemoteTag(t.Context(), "origin", "v")
+	require.NoError(t, err)
+	require.NotNil(t, latest)
+	assert.Equal(t, "v2.0.0-rc.1", latest.Name)
+
+	// The tag type policy applies to remote tags as well
+	repo.SetTagType(TagAnnotated, TagTypeIgnore)
+	tags, err = repo.RemoteTags(t.Context(), "origin")
+	require.NoError(t, err)
+	assert.Len(t, repo.releaseTags(tags), 2)
+}
+
+func TestRepository_RemoteTags_Errors(t *testing.T) {
+	emptyDir := t.TempDir()
+	runGit(t, emptyDir, "init", "--bare")
+	repo, err := OpenRemote(emptyDir)
+	require.NoError(t, err)
+	latest, err := repo.LatestRemoteTag(t.Context(), "origin", "v")
+	require.NoError(t, err)
+	assert.Nil(t, latest)
+
+	repo, err = OpenRemote(filepath.Join(t.TempDir(), "missing.git"))
+	require.NoError(t, err)
+	_, err = repo.RemoteTags(t.Context(), "origin")
+	assert.ErrorIs(t, err, ErrRemoteUnreachable)
+}
@@ -1,15 +1,32 @@
 package git
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+
+	"github.com/benny123tw/bumpkin/internal/version"
 )
 
+// ErrBareRepository is returned for operations that need a worktree, like
+// committing or checking out, in a bare repository
+var ErrBareRepository = errors.New("bare repository has no worktree")
+
 // Repository wraps a git repository
 type Repository struct {
-	Path string
-	repo *git.Repository
+	Path     string
+	repo     *git.Repository
+	scheme   version.Scheme
+	channels version.Channels
+	signer   Signer
+	tagger   Identity
+
+	tagType       TagType
+	tagTypePolicy TagTypePolicy
+
+	native *NativeTransport
 }
 
 // Open opens a git repository at the given path
@@ -31,9 +48,20 @@ func Open(path string) (*Repository, error) {
 // OpenFromCurrent opens a git repository from the current working directory
 // It traverses up the directory tree to find the repository root
 func OpenFromCurrent() (*Repository, error) {
-	repo, err := git.PlainOpenWithOptions(".", &git.PlainOpenOptions{
-		DetectDotGit: true,
-	})
+	return OpenFrom(".")
+}
+
+// OpenFrom opens the git repository at path or any of its parent directories.
+// Bare repositories are supported too; their Path is the repository directory
+// itself, as there is no worktree.
+func OpenFrom(path string) (*Repository, error) {
+	// Detecting .git never finds a bare repository, so path is tried as is first
+	repo, err := git.PlainOpen(path)
+	if err == git.ErrRepositoryNotExists {
+		repo, err = git.PlainOpenWithOptions(path, &git.PlainOpenOptions{
+			DetectDotGit: true,
+		})
+	}
 	if err != nil {
 		if err == git.ErrRepositoryNotExists {
 			return nil, fmt.Errorf("not a git repository (or any of the parent directories)")
@@ -43,6 +71,16 @@ func OpenFromCurrent() (*Repository, error) {
 
 	// Get the worktree to find the root path
 	wt, err := repo.Worktree()
+	if errors.Is(err, git.ErrIsBareRepository) {
+		storage, ok := repo.Storer.(*filesystem.Storage)
+		if !ok {
+			return nil, fmt.Errorf("failed to locate bare repository: %s", path)
+		}
+		return &Repository{
+			Path: storage.Filesystem().Root(),
+			repo: repo,
+		}, nil
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get worktree: %w", err)
 	}
@@ -53,7 +91,41 @@ func OpenFromCurrent() (*Repository, error) {
 	}, nil
 }
 
+// IsBare reports whether the repository has no worktree, like a repository on
+// a git server. Tags can be created in it, but no commits or checkouts.
+func (r *Repository) IsBare() bool {
+	_, err := r.repo.Worktree()
+	return errors.Is(err, git.ErrIsBareRepository)
+}
+
 // Raw returns the underlying go-git repository
 func (r *Repository) Raw() *git.Repository {
 	return r.repo
 }
+
+// SetScheme sets the version scheme used to parse tag names (default: semver)
+func (r *Repository) SetScheme(scheme version.Scheme) {
+	r.scheme = scheme
+}
+
+// Scheme returns the version scheme used to parse tag names
+func (r *Repository) Scheme() version.Scheme {
+	if r.scheme == nil {
+		return version.DefaultScheme()
+	}
+	return r.scheme
+}
+
+// SetChannels sets the prerelease channels, whose order decides which of two
+// prereleases of a version is later (default: alpha, beta, rc)
+func (r *Repository) SetChannels(channels version.Channels) {
+	r.channels = channels
+}
+
+// Channels returns the prerelease channels, least stable first
+func (r *Repository) Channels() version.Channels {
+	if len(r.channels) == 0 {
+		return version.DefaultChannels()
+	}
+	return r.channels
+}
@@ -56,6 +56,56 @@ func TestRepository_Open_CurrentDir(t *testing.T) {
 	assert.NotNil(t, repo)
 }
 
+func TestRepository_OpenFrom_Subdirectory(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	subDir := filepath.Join(tmpDir, "pkg", "sub")
+	require.NoError(t, os.MkdirAll(subDir, 0o755))
+
+	repo, err := OpenFrom(subDir)
+	require.NoError(t, err)
+	assert.Equal(t, tmpDir, repo.Path)
+	assert.False(t, repo.IsBare())
+}
+
+func TestRepository_OpenFrom_Bare(t *testing.T) {
+	workDir := t.TempDir()
+	initRealGitRepo(t, workDir)
+	createTag(t, workDir, "v1.0.0", "Release v1.0.0")
+	runGit(t, workDir, "commit", "--allow-empty", "-m", "feat: next")
+
+	bareDir := filepath.Join(t.TempDir(), "release.git")
+	runGit(t, workDir, "clone", "--bare", workDir, bareDir)
+
+	repo, err := OpenFrom(bareDir)
+	require.NoError(t, err)
+	assert.Equal(t, bareDir, repo.Path)
+	assert.True(t, repo.IsBare())
+
+	// Tags and commits are read from the bare repository
+	latest, err := repo.LatestTag("v")
+	require.NoError(t, err)
+	require.NotNil(t, latest)
+	assert.Equal(t, "v1.0.0", latest.Name)
+	commits, err := repo.GetCommitsSinceTag(latest.Name)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, "feat: next", commits[0].Subject)
+
+	// A bare repository has nothing uncommitted
+	dirty, err := repo.IsDirty(t.Context())
+	require.NoError(t, err)
+	assert.False(t, dirty)
+
+	repo.SetTagger(Identity{Name: "Release Bot", Email: "bot@example.com"})
+	require.NoError(t, repo.CreateTag("v1.1.0", "Release v1.1.0"))
+	tag, err := repo.FindTag("v1.1.0")
+	require.NoError(t, err)
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+	assert.Equal(t, head.String(), tag.CommitHash)
+}
+
 // Helper to initialize a git repo for testing
 func initGitRepo(t *testing.T, dir string) {
 	t.Helper()
@@ -0,0 +1,257 @@
+package git
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"golang.org/x/crypto/ssh"
+)
+
+// Signature formats, named like git's gpg.format
+const (
+	SignOpenPGP = "openpgp"
+	SignSSH     = "ssh"
+)
+
+// sshNamespace is the signature namespace git uses for commits and tags
+const sshNamespace = "git"
+
+// Signer signs encoded tag objects. The returned armored signature is
+// embedded in the tag object, where `git tag -v` verifies it.
+type Signer interface {
+	Sign(message io.Reader) ([]byte, error)
+}
+
+// SetSigner sets the signer used for new annotated tags; nil disables signing
+func (r *Repository) SetSigner(signer Signer) {
+	r.signer = signer
+}
+
+// LoadSigner creates a signer for the format ("openpgp", "gpg" or "ssh").
+// The key is either inline key material (an armored OpenPGP key or an
+// OpenSSH private key) or a path to a keyring or key file. For OpenPGP,
+// keyID selects the key by ID or fingerprint; the first secret key is used
+// when it is empty. The passphrase unlocks encrypted keys.
+func LoadSigner(format, key, keyID, passphrase string) (Signer, error) {
+	if key == "" {
+		return nil, fmt.Errorf("no signing key configured")
+	}
+
+	data, err := readKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case SignOpenPGP, "gpg", "":
+		return NewOpenPGPSigner(data, keyID, passphrase)
+	case SignSSH:
+		return NewSSHSigner(data, passphrase)
+	default:
+		return nil, fmt.Errorf("unknown signing format %q (use openpgp or ssh)", format)
+	}
+}
+
+// readKey returns inline key material as is and reads anything else as a path
+func readKey(key string) ([]byte, error) {
+	if strings.Contains(key, "-----BEGIN ") {
+		return []byte(key), nil
+	}
+
+	key, err := expandHome(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve signing key path: %w", err)
+	}
+
+	data, err := os.ReadFile(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+	return data, nil
+}
+
+// expandHome replaces a leading ~/ with the user's home directory
+func expandHome(path string) (string, error) {
+	rest, ok := strings.CutPrefix(path, "~/")
+	if !ok {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, rest), nil
+}
+
+// openPGPSigner signs with an OpenPGP secret key
+type openPGPSigner struct {
+	entity *openpgp.Entity
+}
+
+// NewOpenPGPSigner creates a signer from an armored or binary keyring
+func NewOpenPGPSigner(keyring []byte, keyID, passphrase string) (Signer, error) {
+	var entities openpgp.EntityList
+	var err error
+	if bytes.Contains(keyring, []byte("-----BEGIN PGP")) {
+		entities, err = openpgp.ReadArmoredKeyRing(bytes.NewReader(keyring))
+	} else {
+		entities, err = openpgp.ReadKeyRing(bytes.NewReader(keyring))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OpenPGP keyring: %w", err)
+	}
+
+	entity := findEntity(entities, keyID)
+	if entity == nil {
+		if keyID != "" {
+			return nil, fmt.Errorf("no OpenPGP secret key %q in keyring", keyID)
+		}
+		return nil, fmt.Errorf("no OpenPGP secret key in keyring")
+	}
+
+	if entity.PrivateKey.Encrypted {
+		if passphrase == "" {
+			return nil, fmt.Errorf("OpenPGP key is encrypted and no passphrase was given")
+		}
+		if err := entity.DecryptPrivateKeys([]byte(passphrase)); err != nil {
+			return nil, fmt.Errorf("failed to decrypt OpenPGP key: %w", err)
+		}
+	}
+
+	return &openPGPSigner{entity: entity}, nil
+}
+
+// findEntity returns the first entity with a secret key whose primary key or
+// a subkey matches keyID by key ID or fingerprint
+func findEntity(entities openpgp.EntityList, keyID string) *openpgp.Entity {
+	keyID = strings.ToUpper(strings.TrimPrefix(keyID, "0x"))
+	keyID = strings.ReplaceAll(keyID, " ", "")
+
+	for _, entity := range entities {
+		if entity.PrivateKey == nil {
+			continue
+		}
+		if keyID == "" {
+			return entity
+		}
+
+		fingerprints := []string{strings.ToUpper(hex.EncodeToString(entity.PrimaryKey.Fingerprint))}
+		for _, subkey := range entity.Subkeys {
+			fingerprints = append(
+				fingerprints,
+				strings.ToUpper(hex.EncodeToString(subkey.PublicKey.Fingerprint)),
+			)
+		}
+		for _, fingerprint := range fingerprints {
+			if strings.HasSuffix(fingerprint, keyID) {
+				return entity
+			}
+		}
+	}
+	return nil
+}
+
+// Sign returns an armored detached OpenPGP signature
+func (s *openPGPSigner) Sign(message io.Reader) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := openpgp.ArmoredDetachSign(&buf, s.entity, message, nil); err != nil {
+		return nil, fmt.Errorf("failed to sign: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// sshSigner signs with an SSH private key in the SSHSIG format that git
+// uses with gpg.format=ssh
+type sshSigner struct {
+	signer ssh.Signer
+}
+
+// NewSSHSigner creates a signer from an OpenSSH or PEM private key
+func NewSSHSigner(key []byte, passphrase string) (Signer, error) {
+	signer, err := ssh.ParsePrivateKey(key)
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) {
+		if passphrase == "" {
+			return nil, fmt.Errorf("SSH key is encrypted and no passphrase was given")
+		}
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(key, []byte(passphrase))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SSH key: %w", err)
+	}
+
+	return &sshSigner{signer: signer}, nil
+}
+
+// Sign returns an armored SSH signature over the SHA-512 of the message
+func (s *sshSigner) Sign(message io.Reader) ([]byte, error) {
+	h := sha512.New()
+	if _, err := io.Copy(h, message); err != nil {
+		return nil, fmt.Errorf("failed to sign: %w", err)
+	}
+
+	signed := ssh.Marshal(struct {
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Hash          string
+	}{sshNamespace, "", "sha512", string(h.Sum(nil))})
+	signed = append([]byte("SSHSIG"), signed...)
+
+	var sig *ssh.Signature
+	var err error
+	if algorithmSigner, ok := s.signer.(ssh.AlgorithmSigner); ok &&
+		s.signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		// ssh-rsa signatures use SHA-1, which ssh-keygen rejects
+		sig, err = algorithmSigner.SignWithAlgorithm(rand.Reader, signed, ssh.KeyAlgoRSASHA512)
+	} else {
+		sig, err = s.signer.Sign(rand.Reader, signed)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign: %w", err)
+	}
+
+	blob := ssh.Marshal(struct {
+		Version       uint32
+		PublicKey     string
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Signature     string
+	}{
+		1,
+		string(s.signer.PublicKey().Marshal()),
+		sshNamespace,
+		"",
+		"sha512",
+		string(ssh.Marshal(sig)),
+	})
+	blob = append([]byte("SSHSIG"), blob...)
+
+	return armorSSHSignature(blob), nil
+}
+
+// armorSSHSignature wraps a signature blob in SSH SIGNATURE armor
+func armorSSHSignature(blob []byte) []byte {
+	encoded := base64.StdEncoding.EncodeToString(blob)
+
+	var buf bytes.Buffer
+	buf.WriteString("-----BEGIN SSH SIGNATURE-----\n")
+	for len(encoded) > 70 {
+		buf.WriteString(encoded[:70] + "\n")
+		encoded = encoded[70:]
+	}
+	buf.WriteString(encoded + "\n")
+	buf.WriteString("-----END SSH SIGNATURE-----\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,149 @@
+package git
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+// verifyTag runs `git tag -v` with extra config and environment
+func verifyTag(t *testing.T, dir, tag string, env []string, config ...string) (string, error) {
+	t.Helper()
+	args := make([]string, 0, len(config)*2+3)
+	for _, c := range config {
+		args = append(args, "-c", c)
+	}
+	args = append(args, "tag", "-v", tag)
+
+	cmd := exec.CommandContext(t.Context(), "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
+
+// generateSSHKey writes an ed25519 private key and an allowed signers file
+func generateSSHKey(t *testing.T, dir string) (keyPath, allowedSigners string) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	require.NoError(t, err)
+	keyPath = filepath.Join(dir, "id_ed25519")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(block), 0o600))
+
+	sshPub, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	allowedSigners = filepath.Join(dir, "allowed_signers")
+	line := "test@example.com " + strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub)))
+	require.NoError(t, os.WriteFile(allowedSigners, []byte(line+"\n"), 0o600))
+
+	return keyPath, allowedSigners
+}
+
+// generateOpenPGPKey returns an armored secret key and its armored public key
+func generateOpenPGPKey(t *testing.T) (secret, public string) {
+	t.Helper()
+	entity, err := openpgp.NewEntity("Test User", "", "test@example.com", nil)
+	require.NoError(t, err)
+
+	var secretBuf, publicBuf bytes.Buffer
+	w, err := armor.Encode(&secretBuf, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.SerializePrivate(w, nil))
+	require.NoError(t, w.Close())
+
+	w, err = armor.Encode(&publicBuf, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+
+	return secretBuf.String(), publicBuf.String()
+}
+
+func TestRepository_CreateTag_SSHSigned(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen not installed")
+	}
+
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	keyPath, allowedSigners := generateSSHKey(t, t.TempDir())
+
+	signer, err := LoadSigner(SignSSH, keyPath, "", "")
+	require.NoError(t, err)
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+	repo.SetSigner(signer)
+	require.NoError(t, repo.CreateTag("v1.0.0", "Release v1.0.0"))
+
+	out, err := verifyTag(t, tmpDir, "v1.0.0", nil,
+		"gpg.format=ssh", "gpg.ssh.allowedSignersFile="+allowedSigners)
+	require.NoError(t, err, out)
+	assert.Contains(t, out, `Good "git" signature`)
+
+	tag, err := repo.FindTag("v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "Release v1.0.0", tag.Message)
+	require.NotNil(t, tag.Version)
+}
+
+func TestRepository_CreateTag_OpenPGPSigned(t *testing.T) {
+	if _, err := exec.LookPath("gpg"); err != nil {
+		t.Skip("gpg not installed")
+	}
+
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	secret, public := generateOpenPGPKey(t)
+
+	gnupgHome := t.TempDir()
+	env := []string{"GNUPGHOME=" + gnupgHome}
+	importCmd := exec.CommandContext(t.Context(), "gpg", "--batch", "--import")
+	importCmd.Env = append(os.Environ(), env...)
+	importCmd.Stdin = strings.NewReader(public)
+	out, err := importCmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	// Inline armored key material works as well as a keyring path
+	signer, err := LoadSigner(SignOpenPGP, secret, "", "")
+	require.NoError(t, err)
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+	repo.SetSigner(signer)
+	require.NoError(t, repo.CreateTag("v1.0.0", "Release v1.0.0"))
+
+	output, err := verifyTag(t, tmpDir, "v1.0.0", env)
+	require.NoError(t, err, output)
+	assert.Contains(t, output, "Good signature")
+}
+
+func TestLoadSigner_Errors(t *testing.T) {
+	_, err := LoadSigner(SignSSH, "", "", "")
+	assert.Error(t, err)
+
+	_, err = LoadSigner("x509", "-----BEGIN KEY-----", "", "")
+	assert.ErrorContains(t, err, "unknown signing format")
+
+	_, err = LoadSigner(SignSSH, filepath.Join(t.TempDir(), "missing"), "", "")
+	assert.ErrorContains(t, err, "failed to read signing key")
+
+	secret, _ := generateOpenPGPKey(t)
+	_, err = LoadSigner(SignOpenPGP, secret, "DEADBEEFDEADBEEF", "")
+	assert.ErrorContains(t, err, "no OpenPGP secret key")
+}
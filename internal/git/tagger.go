@@ -0,0 +1,57 @@
+package git
+
+import (
+	"errors"
+	"os"
+
+	"github.com/go-git/go-git/v5/config"
+)
+
+// ErrMissingTagger is returned when an annotated tag is created without a
+// tagger name or email
+var ErrMissingTagger = errors.New("no tagger identity is configured")
+
+// Identity is the name and email recorded as the tagger of annotated tags
+type Identity struct {
+	Name  string
+	Email string
+}
+
+// SetTagger sets an explicit tagger identity. Empty fields are resolved like
+// git does, see Tagger.
+func (r *Repository) SetTagger(identity Identity) {
+	r.tagger = identity
+}
+
+// Tagger returns the identity used for new annotated tags. Each field comes
+// from the identity set with SetTagger, then GIT_COMMITTER_NAME and
+// GIT_COMMITTER_EMAIL, then user.name and user.email from the local, global
+// and system git config. Fields that cannot be resolved are empty.
+func (r *Repository) Tagger() Identity {
+	identity := r.tagger
+	if identity.Name == "" {
+		identity.Name = os.Getenv("GIT_COMMITTER_NAME")
+	}
+	if identity.Email == "" {
+		identity.Email = os.Getenv("GIT_COMMITTER_EMAIL")
+	}
+	if identity.Name != "" && identity.Email != "" {
+		return identity
+	}
+
+	cfg, err := r.repo.ConfigScoped(config.SystemScope)
+	if err != nil {
+		// Unreadable global or system config; the local config still counts
+		cfg, err = r.repo.Config()
+		if err != nil {
+			return identity
+		}
+	}
+	if identity.Name == "" {
+		identity.Name = cfg.User.Name
+	}
+	if identity.Email == "" {
+		identity.Email = cfg.User.Email
+	}
+	return identity
+}
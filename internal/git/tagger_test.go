@@ -0,0 +1,81 @@
+package git
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// isolateIdentity hides the caller's committer variables and global git config
+func isolateIdentity(t *testing.T) {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", home)
+	for _, key := range []string{"GIT_COMMITTER_NAME", "GIT_COMMITTER_EMAIL"} {
+		t.Setenv(key, "") // restores the variable after the test
+		require.NoError(t, os.Unsetenv(key))
+	}
+}
+
+func TestRepository_Tagger(t *testing.T) {
+	isolateIdentity(t)
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+
+	// Git config
+	assert.Equal(t, Identity{Name: "Test User", Email: "test@example.com"}, repo.Tagger())
+
+	// The environment overrides the git config
+	t.Setenv("GIT_COMMITTER_NAME", "CI Bot")
+	assert.Equal(t, Identity{Name: "CI Bot", Email: "test@example.com"}, repo.Tagger())
+
+	// An explicit identity overrides both
+	repo.SetTagger(Identity{Email: "release@example.com"})
+	assert.Equal(t, Identity{Name: "CI Bot", Email: "release@example.com"}, repo.Tagger())
+}
+
+func TestRepository_CreateTag_Tagger(t *testing.T) {
+	isolateIdentity(t)
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+
+	// The tagger is the person releasing, not the author of HEAD
+	runGit(t, tmpDir, "commit", "--allow-empty", "-m", "feat: contributed",
+		"--author", "Contributor <contributor@example.com>")
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+	repo.SetTagger(Identity{Name: "Release Manager", Email: "release@example.com"})
+	require.NoError(t, repo.CreateTag("v1.0.0", "Release v1.0.0"))
+
+	tag, err := repo.FindTag("v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "Release Manager", tag.Tagger)
+	assert.Equal(t, "release@example.com", tag.TaggerEmail)
+}
+
+func TestRepository_CreateTag_MissingTagger(t *testing.T) {
+	isolateIdentity(t)
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	runGit(t, tmpDir, "config", "--unset", "user.name")
+	runGit(t, tmpDir, "config", "--unset", "user.email")
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, Identity{}, repo.Tagger())
+
+	// The author of HEAD is not taken as the person releasing
+	err = repo.CreateTag("v1.0.0", "Release v1.0.0")
+	require.ErrorIs(t, err, ErrMissingTagger)
+	assert.Contains(t, err.Error(), "--tagger-name")
+
+	_, err = repo.FindTag("v1.0.0")
+	assert.Error(t, err)
+}
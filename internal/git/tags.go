@@ -2,6 +2,7 @@ package git
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -22,6 +23,10 @@ type Tag struct {
 	Timestamp   time.Time
 	IsAnnotated bool
 	Version     *version.Version
+
+	Signature       string // Armored signature of a signed annotated tag
+	SignatureFormat string // "openpgp" or "ssh"; empty for unsigned tags
+	SignerKey       string // Fingerprint or key ID of the signing key, not verified
 }
 
 // ListTags returns all tags in the repository
@@ -48,6 +53,8 @@ func (r *Repository) ListTags() ([]*Tag, error) {
 			tag.Message = strings.TrimSpace(tagObj.Message)
 			tag.Timestamp = tagObj.Tagger.When
 			tag.CommitHash = tagObj.Target.String()
+			tag.Signature = tagObj.PGPSignature
+			tag.SignatureFormat, tag.SignerKey = signatureInfo(tagObj.PGPSignature)
 		} else {
 			// Lightweight tag - ref points directly to commit
 			tag.IsAnnotated = false
@@ -60,11 +67,7 @@ func (r *Repository) ListTags() ([]*Tag, error) {
 			}
 		}
 
-		// Try to parse as semver
-		if v, err := version.Parse(tag.Name); err == nil {
-			tag.Version = &v
-		}
-
+		tag.Version = r.tagVersion(tag.Name)
 		tags = append(tags, tag)
 		return nil
 	})
@@ -75,6 +78,17 @@ func (r *Repository) ListTags() ([]*Tag, error) {
 	return tags, nil
 }
 
+// tagVersion parses a tag name with the repository's version scheme, or
+// returns nil. Floating tags like v1 or v1.4 are not releases of 1.0.0 or 1.4.0.
+func (r *Repository) tagVersion(name string) *version.Version {
+	scheme := r.Scheme()
+	v, err := scheme.Parse(name)
+	if err != nil || (scheme.Name() == "semver" && version.IsFloating(name)) {
+		return nil
+	}
+	return &v
+}
+
 // LatestTag returns the most recent semver tag with the given prefix
 // Returns nil if no matching tags found
 func (r *Repository) LatestTag(prefix string) (*Tag, error) {
@@ -83,6 +97,64 @@ func (r *Repository) LatestTag(prefix string) (*Tag, error) {
 		return nil, err
 	}
 
+	return latestTag(r.releaseTags(tags), prefix, r.Channels()), nil
+}
+
+// LatestTagAt returns the most recent semver tag with the given prefix among
+// the tags reachable from the given commit.
+// Returns nil if no matching tags found
+func (r *Repository) LatestTagAt(prefix string, hash plumbing.Hash) (*Tag, error) {
+	tags, err := r.ListTags()
+	if err != nil {
+		return nil, err
+	}
+
+	reachable, err := r.reachableCommits(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*Tag
+	for _, tag := range r.releaseTags(tags) {
+		if reachable[plumbing.NewHash(tag.CommitHash)] {
+			candidates = append(candidates, tag)
+		}
+	}
+
+	return latestTag(candidates, prefix, r.Channels()), nil
+}
+
+// TagsAt returns the version tags with the given prefix that are reachable
+// from the given commit, lowest version first
+func (r *Repository) TagsAt(prefix string, hash plumbing.Hash) ([]*Tag, error) {
+	tags, err := r.ListTags()
+	if err != nil {
+		return nil, err
+	}
+
+	reachable, err := r.reachableCommits(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var chain []*Tag
+	for _, tag := range r.releaseTags(tags) {
+		if strings.HasPrefix(tag.Name, prefix) && tag.Version != nil &&
+			reachable[plumbing.NewHash(tag.CommitHash)] {
+			chain = append(chain, tag)
+		}
+	}
+	channels := r.Channels()
+	slices.SortStableFunc(chain, func(a, b *Tag) int {
+		return channels.Compare(*a.Version, *b.Version)
+	})
+
+	return chain, nil
+}
+
+// latestTag returns the highest semver tag with the given prefix, or nil.
+// Prereleases of the same version are ordered by the channels.
+func latestTag(tags []*Tag, prefix string, channels version.Channels) *Tag {
 	var latest *Tag
 	for _, tag := range tags {
 		// Skip tags that don't match prefix
@@ -95,47 +167,87 @@ func (r *Repository) LatestTag(prefix string) (*Tag, error) {
 			continue
 		}
 
-		if latest == nil || latest.Version.LessThan(*tag.Version) {
+		if latest == nil || channels.Compare(*latest.Version, *tag.Version) < 0 {
 			latest = tag
 		}
 	}
 
-	return latest, nil
+	return latest
 }
 
-// CreateTag creates an annotated tag at HEAD
-func (r *Repository) CreateTag(name, message string) error {
-	// Check if tag already exists
+// FindTag returns the tag with the given name
+func (r *Repository) FindTag(name string) (*Tag, error) {
 	tags, err := r.ListTags()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for _, tag := range tags {
 		if tag.Name == name {
-			return fmt.Errorf("tag %q already exists", name)
+			return tag, nil
 		}
 	}
 
+	return nil, fmt.Errorf("tag %q not found", name)
+}
+
+// CreateTag creates a tag at HEAD, see CreateTagAt
+func (r *Repository) CreateTag(name, message string) error {
 	// Get HEAD reference
 	head, err := r.repo.Head()
 	if err != nil {
 		return fmt.Errorf("failed to get HEAD: %w", err)
 	}
 
+	return r.CreateTagAt(name, message, head.Hash())
+}
+
+// CreateTagAt creates a tag at the given commit. Tags are annotated unless
+// the tag type is lightweight, in which case the message is not recorded.
+func (r *Repository) CreateTagAt(name, message string, hash plumbing.Hash) error {
+	if r.TagType() == TagLightweight {
+		if r.signer != nil {
+			return fmt.Errorf("lightweight tags cannot be signed")
+		}
+		return r.CreateLightweightTag(name, hash)
+	}
+
+	// Check if tag already exists
+	tags, err := r.ListTags()
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if tag.Name == name {
+			return fmt.Errorf("tag %q already exists", name)
+		}
+	}
+
 	// Get the commit object
-	commit, err := r.repo.CommitObject(head.Hash())
+	commit, err := r.repo.CommitObject(hash)
 	if err != nil {
 		return fmt.Errorf("failed to get commit: %w", err)
 	}
 
+	identity := r.Tagger()
+	if identity.Name == "" || identity.Email == "" {
+		return fmt.Errorf(
+			"%w: set user.name/user.email or --tagger-name/--tagger-email", ErrMissingTagger,
+		)
+	}
+	tagger := &object.Signature{
+		Name:  identity.Name,
+		Email: identity.Email,
+		When:  time.Now(),
+	}
+	if r.signer != nil {
+		return r.createSignedTag(name, message, tagger, commit.Hash)
+	}
+
 	// Create annotated tag
 	_, err = r.repo.CreateTag(name, commit.Hash, &git.CreateTagOptions{
-		Tagger: &object.Signature{
-			Name:  commit.Author.Name,
-			Email: commit.Author.Email,
-			When:  time.Now(),
-		},
+		Tagger:  tagger,
 		Message: message,
 	})
 	if err != nil {
@@ -144,3 +256,86 @@ func (r *Repository) CreateTag(name, message string) error {
 
 	return nil
 }
+
+// createSignedTag creates an annotated tag object signed by the repository's
+// signer. go-git only signs with OpenPGP entities, so the object is built here.
+func (r *Repository) createSignedTag(
+	name, message string,
+	tagger *object.Signature,
+	hash plumbing.Hash,
+) error {
+	if strings.TrimSpace(message) == "" {
+		return fmt.Errorf("failed to create tag: %w", git.ErrMissingMessage)
+	}
+
+	tag := &object.Tag{
+		Name:       name,
+		Tagger:     *tagger,
+		Message:    strings.TrimSpace(message) + "\n",
+		TargetType: plumbing.CommitObject,
+		Target:     hash,
+	}
+
+	payload := &plumbing.MemoryObject{}
+	if err := tag.EncodeWithoutSignature(payload); err != nil {
+		return fmt.Errorf("failed to encode tag: %w", err)
+	}
+	reader, err := payload.Reader()
+	if err != nil {
+		return fmt.Errorf("failed to encode tag: %w", err)
+	}
+	signature, err := r.signer.Sign(reader)
+	if err != nil {
+		return fmt.Errorf("failed to sign tag: %w", err)
+	}
+	tag.PGPSignature = string(signature)
+
+	obj := r.repo.Storer.NewEncodedObject()
+	if err := tag.Encode(obj); err != nil {
+		return fmt.Errorf("failed to encode tag: %w", err)
+	}
+	tagHash, err := r.repo.Storer.SetEncodedObject(obj)
+	if err != nil {
+		return fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	ref := plumbing.NewHashReference(plumbing.NewTagReferenceName(name), tagHash)
+	if err := r.repo.Storer.SetReference(ref); err != nil {
+		return fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return nil
+}
+
+// CreateLightweightTag creates a lightweight tag pointing directly at the given commit
+func (r *Repository) CreateLightweightTag(name string, hash plumbing.Hash) error {
+	if _, err := r.repo.Tag(name); err == nil {
+		return fmt.Errorf("tag %q already exists", name)
+	}
+
+	if _, err := r.repo.CommitObject(hash); err != nil {
+		return fmt.Errorf("failed to get commit: %w", err)
+	}
+
+	ref := plumbing.NewHashReference(plumbing.NewTagReferenceName(name), hash)
+	if err := r.repo.Storer.SetReference(ref); err != nil {
+		return fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return nil
+}
+
+// MoveTag points a lightweight tag at the given commit, creating it or
+// replacing whatever the tag pointed at before
+func (r *Repository) MoveTag(name string, hash plumbing.Hash) error {
+	if _, err := r.repo.CommitObject(hash); err != nil {
+		return fmt.Errorf("failed to get commit: %w", err)
+	}
+
+	ref := plumbing.NewHashReference(plumbing.NewTagReferenceName(name), hash)
+	if err := r.repo.Storer.SetReference(ref); err != nil {
+		return fmt.Errorf("failed to move tag %q: %w", name, err)
+	}
+
+	return nil
+}
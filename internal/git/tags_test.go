@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -186,3 +187,122 @@ func runGit(t *testing.T, dir string, args ...string) {
 	output, err := cmd.CombinedOutput()
 	require.NoError(t, err, "git %v failed: %s", args, string(output))
 }
+
+func TestRepository_CreateTagAt(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir, "v1.0.0-rc.0", "Release v1.0.0-rc.0")
+	createCommit(t, tmpDir, "fix: after rc")
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+
+	rc, err := repo.FindTag("v1.0.0-rc.0")
+	require.NoError(t, err)
+
+	err = repo.CreateTagAt("v1.0.0", "Release v1.0.0", plumbing.NewHash(rc.CommitHash))
+	require.NoError(t, err)
+
+	release, err := repo.FindTag("v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, rc.CommitHash, release.CommitHash)
+
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+	assert.NotEqual(t, head.String(), release.CommitHash)
+}
+
+func TestRepository_FindTag_NotFound(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+
+	_, err = repo.FindTag("v9.9.9")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestRepository_LatestTagAt(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir, "v1.0.0", "Release v1.0.0")
+	createCommit(t, tmpDir, "feat: first")
+	createCommit(t, tmpDir, "feat: second")
+	createTag(t, tmpDir, "v1.1.0", "Release v1.1.0")
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+
+	hash, err := repo.ResolveRevision("HEAD~1")
+	require.NoError(t, err)
+
+	tag, err := repo.LatestTagAt("v", hash)
+	require.NoError(t, err)
+	require.NotNil(t, tag)
+	assert.Equal(t, "v1.0.0", tag.Name)
+
+	commits, err := repo.GetCommitsSinceTagAt(tag.Name, hash)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, "feat: first", commits[0].Subject)
+}
+
+func TestRepository_ResolveRevision_Invalid(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+
+	_, err = repo.ResolveRevision("no-such-branch")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to resolve revision")
+}
+
+func TestRepository_CreateLightweightTag(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	require.NoError(t, repo.CreateLightweightTag("v1.0.0-main.0+gabc1234", head))
+
+	tag, err := repo.FindTag("v1.0.0-main.0+gabc1234")
+	require.NoError(t, err)
+	assert.False(t, tag.IsAnnotated)
+	assert.Equal(t, head.String(), tag.CommitHash)
+
+	err = repo.CreateLightweightTag("v1.0.0-main.0+gabc1234", head)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+}
+
+func TestRepository_MoveTag(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir, "v1.4.0", "Release 1.4.0")
+	runGit(t, tmpDir, "tag", "v1.4")
+	runGit(t, tmpDir, "commit", "--allow-empty", "-m", "fix: bug")
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	require.NoError(t, repo.MoveTag("v1.4", head))
+
+	tag, err := repo.FindTag("v1.4")
+	require.NoError(t, err)
+	assert.Equal(t, head.String(), tag.CommitHash)
+	assert.Nil(t, tag.Version, "floating tags are not releases")
+
+	latest, err := repo.LatestTag("v")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.4.0", latest.Name)
+}
@@ -0,0 +1,104 @@
+package git
+
+import "fmt"
+
+// TagType is the kind of tag created for releases
+type TagType string
+
+const (
+	// TagAnnotated creates tag objects with a tagger, date and message
+	TagAnnotated TagType = "annotated"
+	// TagLightweight creates refs pointing directly at the commit
+	TagLightweight TagType = "lightweight"
+)
+
+// ParseTagType parses a tag type; empty means TagAnnotated
+func ParseTagType(s string) (TagType, error) {
+	switch TagType(s) {
+	case "", TagAnnotated:
+		return TagAnnotated, nil
+	case TagLightweight:
+		return TagLightweight, nil
+	default:
+		return "", fmt.Errorf("unknown tag type %q (use annotated or lightweight)", s)
+	}
+}
+
+// TagTypePolicy controls how version tags of the other type are treated when
+// picking the latest tag
+type TagTypePolicy string
+
+const (
+	// TagTypeAllow uses tags of either type
+	TagTypeAllow TagTypePolicy = "allow"
+	// TagTypeWarn uses tags of either type; TagTypeWarning reports mismatches
+	TagTypeWarn TagTypePolicy = "warn"
+	// TagTypeIgnore skips tags of the other type
+	TagTypeIgnore TagTypePolicy = "ignore"
+)
+
+// ParseTagTypePolicy parses a tag type policy; empty means TagTypeAllow
+func ParseTagTypePolicy(s string) (TagTypePolicy, error) {
+	switch TagTypePolicy(s) {
+	case "", TagTypeAllow:
+		return TagTypeAllow, nil
+	case TagTypeWarn:
+		return TagTypeWarn, nil
+	case TagTypeIgnore:
+		return TagTypeIgnore, nil
+	default:
+		return "", fmt.Errorf("unknown tag type policy %q (use allow, warn or ignore)", s)
+	}
+}
+
+// SetTagType sets the type of new release tags and how LatestTag, LatestTagAt
+// and TagsAt treat existing tags of the other type
+func (r *Repository) SetTagType(tagType TagType, policy TagTypePolicy) {
+	r.tagType = tagType
+	r.tagTypePolicy = policy
+}
+
+// TagType returns the type of new release tags (default: annotated)
+func (r *Repository) TagType() TagType {
+	if r.tagType == "" {
+		return TagAnnotated
+	}
+	return r.tagType
+}
+
+// TagTypeWarning describes a tag of the other type under TagTypeWarn, e.g.
+// "tag v1.2.0 is lightweight, expected annotated". It is empty otherwise.
+func (r *Repository) TagTypeWarning(tag *Tag) string {
+	if tag == nil || r.tagTypePolicy != TagTypeWarn || r.hasTagType(tag) {
+		return ""
+	}
+	return fmt.Sprintf("tag %s is %s, expected %s", tag.Name, tagTypeOf(tag), r.TagType())
+}
+
+// releaseTags drops tags of the other type under TagTypeIgnore
+func (r *Repository) releaseTags(tags []*Tag) []*Tag {
+	if r.tagTypePolicy != TagTypeIgnore {
+		return tags
+	}
+
+	var matching []*Tag
+	for _, tag := range tags {
+		if r.hasTagType(tag) {
+			matching = append(matching, tag)
+		}
+	}
+	return matching
+}
+
+// hasTagType reports whether tag is of the type new release tags get
+func (r *Repository) hasTagType(tag *Tag) bool {
+	return tagTypeOf(tag) == r.TagType()
+}
+
+// tagTypeOf returns the type of an existing tag
+func tagTypeOf(tag *Tag) TagType {
+	if tag.IsAnnotated {
+		return TagAnnotated
+	}
+	return TagLightweight
+}
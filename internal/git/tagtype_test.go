@@ -0,0 +1,100 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTagType(t *testing.T) {
+	tagType, err := ParseTagType("")
+	require.NoError(t, err)
+	assert.Equal(t, TagAnnotated, tagType)
+
+	tagType, err = ParseTagType("lightweight")
+	require.NoError(t, err)
+	assert.Equal(t, TagLightweight, tagType)
+
+	_, err = ParseTagType("signed")
+	assert.ErrorContains(t, err, "unknown tag type")
+
+	policy, err := ParseTagTypePolicy("")
+	require.NoError(t, err)
+	assert.Equal(t, TagTypeAllow, policy)
+
+	policy, err = ParseTagTypePolicy("ignore")
+	require.NoError(t, err)
+	assert.Equal(t, TagTypeIgnore, policy)
+
+	_, err = ParseTagTypePolicy("strict")
+	assert.ErrorContains(t, err, "unknown tag type policy")
+}
+
+func TestRepository_LatestTag_TagTypePolicy(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir, "v1.0.0", "Release v1.0.0")
+	runGit(t, tmpDir, "commit", "--allow-empty", "-m", "fix: bug")
+	runGit(t, tmpDir, "tag", "v1.0.1")
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		policy  TagTypePolicy
+		want    string
+		warning string
+	}{
+		{"allow", TagTypeAllow, "v1.0.1", ""},
+		{"warn", TagTypeWarn, "v1.0.1", "tag v1.0.1 is lightweight, expected annotated"},
+		{"ignore", TagTypeIgnore, "v1.0.0", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo.SetTagType(TagAnnotated, tt.policy)
+
+			latest, err := repo.LatestTag("v")
+			require.NoError(t, err)
+			require.NotNil(t, latest)
+			assert.Equal(t, tt.want, latest.Name)
+			assert.Equal(t, tt.warning, repo.TagTypeWarning(latest))
+
+			head, err := repo.GetHEAD()
+			require.NoError(t, err)
+			latest, err = repo.LatestTagAt("v", head)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, latest.Name)
+		})
+	}
+
+	// The policy applies to the configured type
+	repo.SetTagType(TagLightweight, TagTypeIgnore)
+	latest, err := repo.LatestTag("v")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.1", latest.Name)
+}
+
+func TestRepository_CreateTag_Lightweight(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+	repo.SetTagType(TagLightweight, TagTypeAllow)
+	require.NoError(t, repo.CreateTag("v1.0.0", "Release v1.0.0"))
+
+	tag, err := repo.FindTag("v1.0.0")
+	require.NoError(t, err)
+	assert.False(t, tag.IsAnnotated)
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+	assert.Equal(t, head.String(), tag.CommitHash)
+
+	keyPath, _ := generateSSHKey(t, t.TempDir())
+	signer, err := LoadSigner(SignSSH, keyPath, "", "")
+	require.NoError(t, err)
+	repo.SetSigner(signer)
+	assert.ErrorContains(t, repo.CreateTag("v1.0.1", "Release v1.0.1"), "cannot be signed")
+}
@@ -0,0 +1,243 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"golang.org/x/crypto/ssh"
+)
+
+// NativeTransport configures pushes through go-git instead of the git
+// binary, for containers without git. SSH remotes authenticate with SSHKey,
+// or the SSH agent when it is empty, and check host keys against known_hosts.
+// HTTP remotes use Username and Password, or the configured credential.helper.
+type NativeTransport struct {
+	SSHKey              string   // Private key file or inline key (default: SSH agent)
+	SSHPassphrase       string   // Passphrase of an encrypted SSHKey
+	KnownHosts          []string // known_hosts files (default: $SSH_KNOWN_HOSTS, ~/.ssh/known_hosts)
+	InsecureSkipHostKey bool     // Accept any SSH host key; never use this in production
+	Username            string   // HTTP username (default: "git" when only a Password is set)
+	Password            string   // HTTP password or access token
+}
+
+// SetNativeTransport pushes with go-git using the given settings; nil goes
+// back to the git binary
+func (r *Repository) SetNativeTransport(native *NativeTransport) {
+	r.native = native
+}
+
+// pushNative pushes refspecs to the remote with go-git. Atomic pushes are
+// only atomic if the remote supports them, as go-git does not refuse otherwise.
+func (r *Repository) pushNative(
+	ctx context.Context,
+	remoteName string,
+	refSpecs []string,
+	atomic bool,
+) error {
+	auth, err := r.nativeAuth(ctx, remoteName)
+	if err != nil {
+		return err
+	}
+
+	specs := make([]config.RefSpec, len(refSpecs))
+	for i, spec := range refSpecs {
+		specs[i] = config.RefSpec(spec)
+	}
+
+	err = r.repo.PushContext(ctx, &git.PushOptions{
+		RemoteName: remoteName,
+		RefSpecs:   specs,
+		Auth:       auth,
+		Atomic:     atomic,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return err
+	}
+	return nil
+}
+
+// listNative lists the remote's refs with go-git, like `git ls-remote`
+func (r *Repository) listNative(
+	ctx context.Context,
+	remoteName string,
+	peeling git.PeelingOption,
+) ([]*plumbing.Reference, error) {
+	auth, err := r.nativeAuth(ctx, remoteName)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := r.repo.Remote(remoteName)
+	if err != nil {
+		return nil, fmt.Errorf("remote %q not found: %w", remoteName, err)
+	}
+	refs, err := remote.ListContext(ctx, &git.ListOptions{Auth: auth, PeelingOption: peeling})
+	if err != nil && !errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// nativeAuth returns the credentials for the remote's URL, from the default
+// settings when no native transport is set
+func (r *Repository) nativeAuth(
+	ctx context.Context,
+	remoteName string,
+) (transport.AuthMethod, error) {
+	url, err := r.GetRemoteURL(remoteName)
+	if err != nil {
+		return nil, err
+	}
+	native := r.native
+	if native == nil {
+		native = &NativeTransport{}
+	}
+	return native.auth(ctx, url, r.credentialHelper())
+}
+
+// auth returns the credentials for the remote URL, or nil for local remotes
+func (t *NativeTransport) auth(
+	ctx context.Context,
+	url, helper string,
+) (transport.AuthMethod, error) {
+	ep, err := transport.NewEndpoint(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote URL: %w", err)
+	}
+
+	switch ep.Protocol {
+	case "ssh":
+		return t.sshAuth(ep)
+	case "http", "https":
+		if t.Username != "" || t.Password != "" {
+			username := t.Username
+			if username == "" {
+				username = "git"
+			}
+			return &http.BasicAuth{Username: username, Password: t.Password}, nil
+		}
+		if helper == "" {
+			return nil, nil
+		}
+		return credentialFill(ctx, helper, ep)
+	default:
+		return nil, nil
+	}
+}
+
+// sshAuth authenticates with the key file or the SSH agent, checking the
+// host key against known_hosts
+func (t *NativeTransport) sshAuth(ep *transport.Endpoint) (transport.AuthMethod, error) {
+	user := ep.User
+	if user == "" {
+		user = "git"
+	}
+
+	var hostKeyCallback ssh.HostKeyCallback
+	if t.InsecureSkipHostKey {
+		hostKeyCallback = ssh.InsecureIgnoreHostKey() //nolint:gosec // explicitly requested
+	} else {
+		files := make([]string, len(t.KnownHosts))
+		for i, file := range t.KnownHosts {
+			path, err := expandHome(file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load known_hosts: %w", err)
+			}
+			files[i] = path
+		}
+		callback, err := gitssh.NewKnownHostsCallback(files...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load known_hosts: %w", err)
+		}
+		hostKeyCallback = callback
+	}
+
+	if t.SSHKey == "" {
+		auth, err := gitssh.NewSSHAgentAuth(user)
+		if err != nil {
+			return nil, fmt.Errorf("failed to use SSH agent: %w", err)
+		}
+		auth.HostKeyCallback = hostKeyCallback
+		return auth, nil
+	}
+
+	key, err := readKey(t.SSHKey)
+	if err != nil {
+		return nil, err
+	}
+	auth, err := gitssh.NewPublicKeys(user, key, t.SSHPassphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SSH key: %w", err)
+	}
+	auth.HostKeyCallback = hostKeyCallback
+	return auth, nil
+}
+
+// credentialHelper returns the credential.helper from the git config, if any
+func (r *Repository) credentialHelper() string {
+	cfg, err := r.repo.ConfigScoped(config.SystemScope)
+	if err != nil {
+		return ""
+	}
+	return cfg.Raw.Section("credential").Option("helper")
+}
+
+// credentialFill asks a git credential helper for the endpoint's username
+// and password, like `git credential fill`. The helper is run as git would:
+// "!cmd" as a shell command, absolute paths as is, and other names as
+// git-credential-<name>.
+func credentialFill(
+	ctx context.Context,
+	helper string,
+	ep *transport.Endpoint,
+) (transport.AuthMethod, error) {
+	command := helper
+	switch {
+	case strings.HasPrefix(helper, "!"):
+		command = helper[1:]
+	case !strings.HasPrefix(helper, "/"):
+		command = "git-credential-" + helper
+	}
+
+	host := ep.Host
+	if ep.Port != 0 {
+		host = fmt.Sprintf("%s:%d", ep.Host, ep.Port)
+	}
+	input := fmt.Sprintf(
+		"protocol=%s\nhost=%s\npath=%s\n\n",
+		ep.Protocol, host, strings.TrimPrefix(ep.Path, "/"),
+	)
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", command+" get")
+	cmd.Stdin = strings.NewReader(input)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("credential helper %q failed: %w", helper, err)
+	}
+
+	auth := &http.BasicAuth{}
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		key, value, _ := strings.Cut(scanner.Text(), "=")
+		switch key {
+		case "username":
+			auth.Username = value
+		case "password":
+			auth.Password = value
+		}
+	}
+	if auth.Username == "" && auth.Password == "" {
+		return nil, nil
+	}
+	return auth, nil
+}
@@ -0,0 +1,226 @@
+package git
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+// startSSHServer serves the bare repository at dir over SSH, whatever the
+// path in the URL, accepting only clientKey. It returns the address and host key.
+func startSSHServer(t *testing.T, dir string, clientKey ssh.PublicKey) (string, ssh.PublicKey) {
+	t.Helper()
+
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostSigner, err := ssh.NewSignerFromKey(hostPriv)
+	require.NoError(t, err)
+
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if !bytes.Equal(key.Marshal(), clientKey.Marshal()) {
+				return nil, fmt.Errorf("unknown key")
+			}
+			return &ssh.Permissions{}, nil
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSSH(conn, config, dir)
+		}
+	}()
+
+	return listener.Addr().String(), hostSigner.PublicKey()
+}
+
+// serveSSH handles one connection, running git-upload-pack or
+// git-receive-pack on dir for exec requests
+func serveSSH(conn net.Conn, config *ssh.ServerConfig, dir string) {
+	_, channels, requests, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(requests)
+
+	for newChannel := range channels {
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer channel.Close()
+			for req := range requests {
+				var payload struct{ Command string }
+				if req.Type != "exec" || ssh.Unmarshal(req.Payload, &payload) != nil {
+					_ = req.Reply(false, nil)
+					continue
+				}
+				_ = req.Reply(true, nil)
+
+				// e.g. git-receive-pack '/release.git'
+				service, _, _ := strings.Cut(payload.Command, " ")
+				//nolint:gosec // test server
+				cmd := exec.Command("git", strings.TrimPrefix(service, "git-"), dir)
+				cmd.Stdin = channel
+				cmd.Stdout = channel
+				cmd.Stderr = channel.Stderr()
+				status := ssh.Marshal(struct{ Status uint32 }{0})
+				if err := cmd.Run(); err != nil {
+					status = ssh.Marshal(struct{ Status uint32 }{1})
+				}
+				_, _ = channel.SendRequest("exit-status", false, status)
+				return
+			}
+		}()
+	}
+}
+
+// writeClientKey writes an ed25519 private key and returns its path and public key
+func writeClientKey(t *testing.T) (string, ssh.PublicKey) {
+	t.Helper()
+	keyPath, _ := generateSSHKey(t, t.TempDir())
+	data, err := os.ReadFile(keyPath)
+	require.NoError(t, err)
+	signer, err := ssh.ParsePrivateKey(data)
+	require.NoError(t, err)
+	return keyPath, signer.PublicKey()
+}
+
+// writeKnownHosts writes a known_hosts file trusting key for addr
+func writeKnownHosts(t *testing.T, addr string, key ssh.PublicKey) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "known_hosts")
+	line := ssh.MarshalAuthorizedKey(key)
+	line = append([]byte(knownHostsAddr(addr)+" "), line...)
+	require.NoError(t, os.WriteFile(path, line, 0o600))
+	return path
+}
+
+// knownHostsAddr formats host:port the way known_hosts lists non-default ports
+func knownHostsAddr(addr string) string {
+	host, port, _ := strings.Cut(addr, ":")
+	return fmt.Sprintf("[%s]:%s", host, port)
+}
+
+func TestRepository_PushTag_Native(t *testing.T) {
+	remoteDir := t.TempDir()
+	runGit(t, remoteDir, "init", "--bare")
+
+	localDir := t.TempDir()
+	initRealGitRepo(t, localDir)
+	runGit(t, localDir, "remote", "add", "origin", "file://"+remoteDir)
+
+	repo, err := Open(localDir)
+	require.NoError(t, err)
+	repo.SetNativeTransport(&NativeTransport{})
+
+	branch := getCurrentBranch(t, localDir)
+	require.NoError(t, repo.PushBranch(t.Context(), branch, "origin"))
+	require.NoError(t, repo.CreateTag("v1.0.0", "Release v1.0.0"))
+	require.NoError(t, repo.PushTag(t.Context(), "v1.0.0", "origin"))
+
+	// Pushing again is not an error
+	require.NoError(t, repo.PushTag(t.Context(), "v1.0.0", "origin"))
+
+	remote, err := Open(remoteDir)
+	require.NoError(t, err)
+	tag, err := remote.FindTag("v1.0.0")
+	require.NoError(t, err)
+	assert.True(t, tag.IsAnnotated)
+}
+
+func TestRepository_PushTag_NativeSSH(t *testing.T) {
+	remoteDir := t.TempDir()
+	runGit(t, remoteDir, "init", "--bare")
+
+	keyPath, clientKey := writeClientKey(t)
+	addr, hostKey := startSSHServer(t, remoteDir, clientKey)
+
+	localDir := t.TempDir()
+	initRealGitRepo(t, localDir)
+	runGit(t, localDir, "remote", "add", "origin", "ssh://git@"+addr+"/release.git")
+
+	repo, err := Open(localDir)
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateTag("v1.0.0", "Release v1.0.0"))
+
+	// An unknown host key is rejected
+	_, otherKey := writeClientKey(t)
+	repo.SetNativeTransport(&NativeTransport{
+		SSHKey:     keyPath,
+		KnownHosts: []string{writeKnownHosts(t, addr, otherKey)},
+	})
+	err = repo.PushTag(t.Context(), "v1.0.0", "origin")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "key mismatch")
+
+	repo.SetNativeTransport(&NativeTransport{
+		SSHKey:     keyPath,
+		KnownHosts: []string{writeKnownHosts(t, addr, hostKey)},
+	})
+	require.NoError(t, repo.PushTag(t.Context(), "v1.0.0", "origin"))
+
+	remote, err := Open(remoteDir)
+	require.NoError(t, err)
+	_, err = remote.FindTag("v1.0.0")
+	require.NoError(t, err)
+}
+
+func TestNativeTransport_Auth(t *testing.T) {
+	ctx := t.Context()
+
+	// Local remotes need no credentials
+	auth, err := (&NativeTransport{}).auth(ctx, "/srv/git/release.git", "")
+	require.NoError(t, err)
+	assert.Nil(t, auth)
+
+	// A token alone is sent as the password
+	auth, err = (&NativeTransport{Password: "token"}).auth(ctx, "https://example.com/r.git", "")
+	require.NoError(t, err)
+	assert.Equal(t, &http.BasicAuth{Username: "git", Password: "token"}, auth)
+
+	// Otherwise the credential helper is asked
+	helper := `!f() { cat > /dev/null; echo username=helper; echo password=secret; }; f`
+	auth, err = (&NativeTransport{}).auth(ctx, "https://example.com/r.git", helper)
+	require.NoError(t, err)
+	assert.Equal(t, &http.BasicAuth{Username: "helper", Password: "secret"}, auth)
+
+	_, err = (&NativeTransport{}).auth(ctx, "https://example.com/r.git", "!exit 1")
+	assert.ErrorContains(t, err, "credential helper")
+
+	// SSH needs a known_hosts file unless host keys are skipped
+	keyPath, _ := writeClientKey(t)
+	_, err = (&NativeTransport{
+		SSHKey:     keyPath,
+		KnownHosts: []string{filepath.Join(t.TempDir(), "missing")},
+	}).auth(ctx, "git@example.com:org/repo.git", "")
+	assert.ErrorContains(t, err, "known_hosts")
+
+	auth, err = (&NativeTransport{SSHKey: keyPath, InsecureSkipHostKey: true}).auth(
+		ctx, "git@example.com:org/repo.git", "",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "ssh-public-keys", auth.Name())
+}
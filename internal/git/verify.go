@@ -0,0 +1,383 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	pgperrors "github.com/ProtonMail/go-crypto/openpgp/errors"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/go-git/go-git/v5/plumbing"
+	"golang.org/x/crypto/ssh"
+)
+
+// ErrUnsigned is returned when verifying a tag without a signature
+var ErrUnsigned = errors.New("tag is not signed")
+
+// ErrUntrustedSigner is returned when a signature is valid but its key is
+// not in the allowed signers or keyring
+var ErrUntrustedSigner = errors.New("tag is signed by an untrusted key")
+
+// ErrTagNameMismatch is returned when a tag ref points at a tag object that
+// was signed for another tag name, e.g. a signed v1.0.0 pushed as v9.9.9
+var ErrTagNameMismatch = errors.New("tag object is for another tag")
+
+// Verifier checks tag signatures against trusted keys
+type Verifier interface {
+	// Verify checks the armored signature over the payload and returns the
+	// identity of the trusted signer
+	Verify(payload []byte, signature string) (string, error)
+}
+
+// VerifyTag verifies the signature of an annotated tag and returns the
+// identity of the signer. The signed tag object must carry the tag's own
+// name, so a signed tag cannot be replayed under another version.
+func (r *Repository) VerifyTag(name string, verifier Verifier) (string, error) {
+	ref, err := r.repo.Tag(name)
+	if err != nil {
+		return "", fmt.Errorf("tag %q not found: %w", name, err)
+	}
+	tagObj, err := r.repo.TagObject(ref.Hash())
+	if err != nil || tagObj.PGPSignature == "" {
+		return "", ErrUnsigned
+	}
+	if tagObj.Name != name {
+		return "", fmt.Errorf("%w: %s points at %s", ErrTagNameMismatch, name, tagObj.Name)
+	}
+
+	encoded := &plumbing.MemoryObject{}
+	if err := tagObj.EncodeWithoutSignature(encoded); err != nil {
+		return "", fmt.Errorf("failed to encode tag: %w", err)
+	}
+	reader, err := encoded.Reader()
+	if err != nil {
+		return "", fmt.Errorf("failed to encode tag: %w", err)
+	}
+	payload, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode tag: %w", err)
+	}
+
+	return verifier.Verify(payload, tagObj.PGPSignature)
+}
+
+// signatureInfo returns the format of an armored signature and the key that
+// made it, without checking the signature
+func signatureInfo(signature string) (format, key string) {
+	switch {
+	case strings.HasPrefix(signature, "-----BEGIN SSH SIGNATURE-----"):
+		sig, err := parseSSHSignature(signature)
+		if err != nil {
+			return SignSSH, ""
+		}
+		return SignSSH, ssh.FingerprintSHA256(sig.publicKey)
+	case strings.HasPrefix(signature, "-----BEGIN PGP SIGNATURE-----"):
+		sig, err := parseOpenPGPSignature(signature)
+		if err != nil {
+			return SignOpenPGP, ""
+		}
+		if len(sig.IssuerFingerprint) > 0 {
+			return SignOpenPGP, strings.ToUpper(hex.EncodeToString(sig.IssuerFingerprint))
+		}
+		if sig.IssuerKeyId != nil {
+			return SignOpenPGP, fmt.Sprintf("%016X", *sig.IssuerKeyId)
+		}
+		return SignOpenPGP, ""
+	default:
+		return "", ""
+	}
+}
+
+// parseOpenPGPSignature reads the signature packet of an armored signature
+func parseOpenPGPSignature(signature string) (*packet.Signature, error) {
+	block, err := armor.Decode(strings.NewReader(signature))
+	if err != nil {
+		return nil, err
+	}
+	p, err := packet.Read(block.Body)
+	if err != nil {
+		return nil, err
+	}
+	sig, ok := p.(*packet.Signature)
+	if !ok {
+		return nil, fmt.Errorf("not an OpenPGP signature")
+	}
+	return sig, nil
+}
+
+// sshSignature is a decoded SSHSIG signature
+type sshSignature struct {
+	publicKey     ssh.PublicKey
+	namespace     string
+	hashAlgorithm string
+	signature     *ssh.Signature
+}
+
+// parseSSHSignature decodes an armored SSHSIG signature
+func parseSSHSignature(signature string) (*sshSignature, error) {
+	body := strings.TrimSpace(signature)
+	body = strings.TrimPrefix(body, "-----BEGIN SSH SIGNATURE-----")
+	body = strings.TrimSuffix(body, "-----END SSH SIGNATURE-----")
+	blob, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(body), ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSH signature: %w", err)
+	}
+
+	magic := []byte("SSHSIG")
+	if !bytes.HasPrefix(blob, magic) {
+		return nil, fmt.Errorf("invalid SSH signature: missing SSHSIG magic")
+	}
+
+	var decoded struct {
+		Version       uint32
+		PublicKey     string
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Signature     string
+	}
+	if err := ssh.Unmarshal(blob[len(magic):], &decoded); err != nil {
+		return nil, fmt.Errorf("invalid SSH signature: %w", err)
+	}
+	if decoded.Version != 1 {
+		return nil, fmt.Errorf("unsupported SSH signature version %d", decoded.Version)
+	}
+
+	publicKey, err := ssh.ParsePublicKey([]byte(decoded.PublicKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSH signature key: %w", err)
+	}
+	sig := new(ssh.Signature)
+	if err := ssh.Unmarshal([]byte(decoded.Signature), sig); err != nil {
+		return nil, fmt.Errorf("invalid SSH signature: %w", err)
+	}
+
+	return &sshSignature{
+		publicKey:     publicKey,
+		namespace:     decoded.Namespace,
+		hashAlgorithm: decoded.HashAlgorithm,
+		signature:     sig,
+	}, nil
+}
+
+// allowedSigner is one entry of an allowed signers file
+type allowedSigner struct {
+	principals string
+	key        ssh.PublicKey
+	namespaces []string
+}
+
+// sshVerifier verifies SSH signatures against an allowed signers file
+type sshVerifier struct {
+	signers []allowedSigner
+}
+
+// NewSSHVerifier creates a verifier from an allowed signers file in the
+// format of ssh-keygen and git's gpg.ssh.allowedSignersFile:
+//
+//	alice@example.com namespaces="git" ssh-ed25519 AAAA...
+func NewSSHVerifier(allowedSigners []byte) (Verifier, error) {
+	v := &sshVerifier{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(allowedSigners))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		principals, rest, ok := strings.Cut(line, " ")
+		if !ok {
+			return nil, fmt.Errorf("allowed signers line %d: missing key", n)
+		}
+		key, _, options, _, err := ssh.ParseAuthorizedKey([]byte(rest))
+		if err != nil {
+			return nil, fmt.Errorf("allowed signers line %d: %w", n, err)
+		}
+
+		signer := allowedSigner{principals: principals, key: key}
+		for _, option := range options {
+			if value, ok := strings.CutPrefix(option, "namespaces="); ok {
+				signer.namespaces = strings.Split(strings.Trim(value, `"`), ",")
+			}
+		}
+		v.signers = append(v.signers, signer)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read allowed signers: %w", err)
+	}
+
+	return v, nil
+}
+
+// Verify checks an SSH signature made in the git namespace
+func (v *sshVerifier) Verify(payload []byte, signature string) (string, error) {
+	sig, err := parseSSHSignature(signature)
+	if err != nil {
+		return "", err
+	}
+	if sig.namespace != sshNamespace {
+		return "", fmt.Errorf("SSH signature namespace is %q, not %q", sig.namespace, sshNamespace)
+	}
+
+	var digest []byte
+	switch sig.hashAlgorithm {
+	case "sha512":
+		sum := sha512.Sum512(payload)
+		digest = sum[:]
+	case "sha256":
+		sum := sha256.Sum256(payload)
+		digest = sum[:]
+	default:
+		return "", fmt.Errorf("unsupported SSH signature hash %q", sig.hashAlgorithm)
+	}
+
+	signed := ssh.Marshal(struct {
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Hash          string
+	}{sig.namespace, "", sig.hashAlgorithm, string(digest)})
+	signed = append([]byte("SSHSIG"), signed...)
+	if err := sig.publicKey.Verify(signed, sig.signature); err != nil {
+		return "", fmt.Errorf("bad SSH signature: %w", err)
+	}
+
+	keyBytes := sig.publicKey.Marshal()
+	for _, signer := range v.signers {
+		if !bytes.Equal(signer.key.Marshal(), keyBytes) {
+			continue
+		}
+		if len(signer.namespaces) > 0 && !slices.Contains(signer.namespaces, sshNamespace) {
+			continue
+		}
+		return signer.principals, nil
+	}
+
+	return "", fmt.Errorf("%w %s", ErrUntrustedSigner, ssh.FingerprintSHA256(sig.publicKey))
+}
+
+// openPGPVerifier verifies OpenPGP signatures against a keyring
+type openPGPVerifier struct {
+	keyring openpgp.EntityList
+}
+
+// NewOpenPGPVerifier creates a verifier from an armored or binary keyring of
+// trusted public keys
+func NewOpenPGPVerifier(keyring []byte) (Verifier, error) {
+	var entities openpgp.EntityList
+	var err error
+	if bytes.Contains(keyring, []byte("-----BEGIN PGP")) {
+		entities, err = openpgp.ReadArmoredKeyRing(bytes.NewReader(keyring))
+	} else {
+		entities, err = openpgp.ReadKeyRing(bytes.NewReader(keyring))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OpenPGP keyring: %w", err)
+	}
+
+	return &openPGPVerifier{keyring: entities}, nil
+}
+
+// Verify checks an OpenPGP signature and returns the signer's primary user ID
+func (v *openPGPVerifier) Verify(payload []byte, signature string) (string, error) {
+	entity, err := openpgp.CheckArmoredDetachedSignature(
+		v.keyring, bytes.NewReader(payload), strings.NewReader(signature), nil,
+	)
+	if errors.Is(err, pgperrors.ErrUnknownIssuer) {
+		_, key := signatureInfo(signature)
+		return "", fmt.Errorf("%w %s", ErrUntrustedSigner, key)
+	}
+	if err != nil {
+		return "", fmt.Errorf("bad OpenPGP signature: %w", err)
+	}
+
+	if identity := entity.PrimaryIdentity(); identity != nil {
+		return identity.Name, nil
+	}
+	return strings.ToUpper(hex.EncodeToString(entity.PrimaryKey.Fingerprint)), nil
+}
+
+// formatVerifier dispatches to the verifier for the signature's format
+type formatVerifier map[string]Verifier
+
+// NewVerifier creates a verifier from an allowed signers file for SSH
+// signatures and a keyring for OpenPGP signatures. Either may be nil, in
+// which case signatures of that format are untrusted.
+func NewVerifier(allowedSigners, keyring []byte) (Verifier, error) {
+	v := formatVerifier{}
+	if allowedSigners != nil {
+		sshVerifier, err := NewSSHVerifier(allowedSigners)
+		if err != nil {
+			return nil, err
+		}
+		v[SignSSH] = sshVerifier
+	}
+	if keyring != nil {
+		pgpVerifier, err := NewOpenPGPVerifier(keyring)
+		if err != nil {
+			return nil, err
+		}
+		v[SignOpenPGP] = pgpVerifier
+	}
+	return v, nil
+}
+
+// LoadVerifier creates a verifier from an allowed signers file and an
+// OpenPGP keyring, given as paths or inline content. At least one is needed.
+func LoadVerifier(allowedSigners, keyring string) (Verifier, error) {
+	if allowedSigners == "" && keyring == "" {
+		return nil, fmt.Errorf("no allowed signers file or keyring configured")
+	}
+
+	var signersData, keyringData []byte
+	var err error
+	if allowedSigners != "" {
+		if signersData, err = readFileOrInline(allowedSigners); err != nil {
+			return nil, err
+		}
+	}
+	if keyring != "" {
+		if keyringData, err = readKey(keyring); err != nil {
+			return nil, err
+		}
+	}
+	return NewVerifier(signersData, keyringData)
+}
+
+// readFileOrInline reads an allowed signers path, or returns inline entries
+// (anything with a key in it) as is
+func readFileOrInline(s string) ([]byte, error) {
+	if strings.Contains(s, " ssh-") || strings.Contains(s, " ecdsa-") ||
+		strings.Contains(s, " sk-") {
+		return []byte(s), nil
+	}
+	data, err := readKey(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read allowed signers: %w", err)
+	}
+	return data, nil
+}
+
+// Verify checks the signature with the verifier for its format
+func (v formatVerifier) Verify(payload []byte, signature string) (string, error) {
+	format, key := signatureInfo(signature)
+	if format == "" {
+		return "", fmt.Errorf("unsupported signature format")
+	}
+	verifier, ok := v[format]
+	if !ok {
+		return "", fmt.Errorf("%w %s (no trusted %s keys)", ErrUntrustedSigner, key, format)
+	}
+	return verifier.Verify(payload, signature)
+}
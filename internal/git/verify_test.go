@@ -0,0 +1,159 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_VerifyTag_SSH(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir, "v1.0.0", "Release 1.0.0")
+	keyPath, allowedSigners := generateSSHKey(t, t.TempDir())
+	otherKey, otherSigners := generateSSHKey(t, t.TempDir())
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+
+	signer, err := LoadSigner(SignSSH, keyPath, "", "")
+	require.NoError(t, err)
+	repo.SetSigner(signer)
+	require.NoError(t, repo.CreateTag("v1.1.0", "Release v1.1.0"))
+
+	other, err := LoadSigner(SignSSH, otherKey, "", "")
+	require.NoError(t, err)
+	repo.SetSigner(other)
+	require.NoError(t, repo.CreateTag("v1.2.0", "Release v1.2.0"))
+
+	verifier, err := LoadVerifier(allowedSigners, "")
+	require.NoError(t, err)
+
+	identity, err := repo.VerifyTag("v1.1.0", verifier)
+	require.NoError(t, err)
+	assert.Equal(t, "test@example.com", identity)
+
+	_, err = repo.VerifyTag("v1.2.0", verifier)
+	require.ErrorIs(t, err, ErrUntrustedSigner)
+
+	_, err = repo.VerifyTag("v1.0.0", verifier)
+	require.ErrorIs(t, err, ErrUnsigned)
+
+	// Restricting the key to another namespace makes it untrusted for git
+	data, err := os.ReadFile(otherSigners)
+	require.NoError(t, err)
+	principal, key, _ := strings.Cut(string(data), " ")
+	restricted := filepath.Join(t.TempDir(), "allowed_signers")
+	require.NoError(t, os.WriteFile(
+		restricted, []byte(principal+` namespaces="file" `+key), 0o600,
+	))
+	verifier, err = LoadVerifier(restricted, "")
+	require.NoError(t, err)
+	_, err = repo.VerifyTag("v1.2.0", verifier)
+	require.ErrorIs(t, err, ErrUntrustedSigner)
+}
+
+func TestRepository_VerifyTag_Renamed(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	keyPath, allowedSigners := generateSSHKey(t, t.TempDir())
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+
+	signer, err := LoadSigner(SignSSH, keyPath, "", "")
+	require.NoError(t, err)
+	repo.SetSigner(signer)
+	require.NoError(t, repo.CreateTag("v1.0.0", "Release v1.0.0"))
+
+	// Point another version at the signed v1.0.0 tag object
+	ref, err := repo.repo.Tag("v1.0.0")
+	require.NoError(t, err)
+	runGit(t, tmpDir, "update-ref", "refs/tags/v9.9.9", ref.Hash().String())
+
+	verifier, err := LoadVerifier(allowedSigners, "")
+	require.NoError(t, err)
+
+	_, err = repo.VerifyTag("v1.0.0", verifier)
+	require.NoError(t, err)
+
+	_, err = repo.VerifyTag("v9.9.9", verifier)
+	require.ErrorIs(t, err, ErrTagNameMismatch)
+}
+
+func TestRepository_VerifyTag_OpenPGP(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	secret, public := generateOpenPGPKey(t)
+	_, otherPublic := generateOpenPGPKey(t)
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+	signer, err := LoadSigner(SignOpenPGP, secret, "", "")
+	require.NoError(t, err)
+	repo.SetSigner(signer)
+	require.NoError(t, repo.CreateTag("v1.0.0", "Release v1.0.0"))
+
+	keyring := filepath.Join(t.TempDir(), "keys.asc")
+	require.NoError(t, os.WriteFile(keyring, []byte(public), 0o600))
+	verifier, err := LoadVerifier("", keyring)
+	require.NoError(t, err)
+
+	identity, err := repo.VerifyTag("v1.0.0", verifier)
+	require.NoError(t, err)
+	assert.Equal(t, "Test User <test@example.com>", identity)
+
+	verifier, err = LoadVerifier("", otherPublic)
+	require.NoError(t, err)
+	_, err = repo.VerifyTag("v1.0.0", verifier)
+	require.ErrorIs(t, err, ErrUntrustedSigner)
+
+	// SSH-only trust does not cover OpenPGP signatures
+	_, allowedSigners := generateSSHKey(t, t.TempDir())
+	verifier, err = LoadVerifier(allowedSigners, "")
+	require.NoError(t, err)
+	_, err = repo.VerifyTag("v1.0.0", verifier)
+	require.ErrorIs(t, err, ErrUntrustedSigner)
+}
+
+func TestRepository_ListTags_Signature(t *testing.T) {
+	tmpDir := t.TempDir()
+	initRealGitRepo(t, tmpDir)
+	createTag(t, tmpDir, "v1.0.0", "Release 1.0.0")
+	keyPath, _ := generateSSHKey(t, t.TempDir())
+
+	repo, err := Open(tmpDir)
+	require.NoError(t, err)
+	signer, err := LoadSigner(SignSSH, keyPath, "", "")
+	require.NoError(t, err)
+	repo.SetSigner(signer)
+	require.NoError(t, repo.CreateTag("v1.1.0", "Release v1.1.0"))
+
+	unsigned, err := repo.FindTag("v1.0.0")
+	require.NoError(t, err)
+	assert.Empty(t, unsigned.Signature)
+	assert.Empty(t, unsigned.SignatureFormat)
+
+	signed, err := repo.FindTag("v1.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, SignSSH, signed.SignatureFormat)
+	assert.True(t, strings.HasPrefix(signed.SignerKey, "SHA256:"))
+	assert.Contains(t, signed.Signature, "BEGIN SSH SIGNATURE")
+
+	head, err := repo.GetHEAD()
+	require.NoError(t, err)
+	chain, err := repo.TagsAt("v", head)
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+	assert.Equal(t, "v1.0.0", chain[0].Name)
+	assert.Equal(t, "v1.1.0", chain[1].Name)
+}
+
+func TestNewSSHVerifier_InvalidLine(t *testing.T) {
+	_, err := NewSSHVerifier([]byte("# comment\nalice@example.com\n"))
+	assert.ErrorContains(t, err, "line 2")
+}
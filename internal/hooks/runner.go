@@ -38,15 +38,23 @@ func RunHookWithOutput(
 		return result
 	}
 
+	command, err := hookCtx.Expand(hook.Command)
+	if err != nil {
+		result.Success = false
+		result.Error = err
+		result.Duration = time.Since(start)
+		return result
+	}
+
 	// Create command
 	// Note: G204 is expected here - hooks are user-defined commands from config
 	var cmd *exec.Cmd
 	if runtime.GOOS == "windows" {
 		//nolint:gosec // User-defined hook command from config file
-		cmd = exec.CommandContext(ctx, "cmd", "/C", hook.Command)
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
 	} else {
 		//nolint:gosec // User-defined hook command from config file
-		cmd = exec.CommandContext(ctx, "sh", "-c", hook.Command)
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
 	}
 
 	// Set environment variables
@@ -57,7 +65,7 @@ func RunHookWithOutput(
 	cmd.Stderr = stderr
 
 	// Run command
-	err := cmd.Run()
+	err = cmd.Run()
 	result.Duration = time.Since(start)
 
 	if err != nil {
@@ -153,14 +161,23 @@ func RunHookStreaming(
 			return
 		}
 
+		command, err := hookCtx.Expand(hook.Command)
+		if err != nil {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(start)
+			doneChan <- result
+			return
+		}
+
 		// Create command
 		var cmd *exec.Cmd
 		if runtime.GOOS == "windows" {
 			//nolint:gosec // User-defined hook command from config file
-			cmd = exec.CommandContext(ctx, "cmd", "/C", hook.Command)
+			cmd = exec.CommandContext(ctx, "cmd", "/C", command)
 		} else {
 			//nolint:gosec // User-defined hook command from config file
-			cmd = exec.CommandContext(ctx, "sh", "-c", hook.Command)
+			cmd = exec.CommandContext(ctx, "sh", "-c", command)
 		}
 
 		// Set environment variables
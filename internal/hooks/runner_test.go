@@ -173,6 +173,8 @@ func TestHookContext_ToEnv(t *testing.T) {
 	env := ctx.ToEnv()
 
 	assert.Contains(t, env, "BUMPKIN_VERSION=1.2.3")
+	assert.Contains(t, env, "BUMPKIN_VERSION_PEP440=1.2.3")
+	assert.Contains(t, env, "BUMPKIN_VERSION_MAVEN=1.2.3")
 	assert.Contains(t, env, "BUMPKIN_PREVIOUS_VERSION=1.2.2")
 	assert.Contains(t, env, "BUMPKIN_TAG=v1.2.3")
 	assert.Contains(t, env, "BUMPKIN_PREFIX=v")
@@ -183,6 +185,61 @@ func TestHookContext_ToEnv(t *testing.T) {
 	assert.Contains(t, env, "TAG=v1.2.3")
 }
 
+func TestHookContext_ToEnvConvertedVersions(t *testing.T) {
+	ctx := &HookContext{Version: "1.2.0-rc.1"}
+
+	env := ctx.ToEnv()
+
+	assert.Contains(t, env, "BUMPKIN_VERSION_PEP440=1.2.0rc1")
+	assert.Contains(t, env, "BUMPKIN_VERSION_MAVEN=1.2.0-RC1")
+}
+
+func TestRunHookStreaming_TemplateCommand(t *testing.T) {
+	ctx := context.Background()
+	hookCtx := &HookContext{Version: "1.2.0-rc.1", TagName: "v1.2.0-rc.1", Templates: true}
+
+	hook := Hook{
+		Command: "echo {{ pep440 .Version }} {{ .Version | maven }}",
+		Type:    PreTag,
+	}
+
+	lineChan, doneChan := RunHookStreaming(ctx, hook, hookCtx)
+	result := <-doneChan
+	require.True(t, result.Success)
+
+	var lines []string
+	for line := range lineChan {
+		lines = append(lines, line.Text)
+	}
+	assert.Equal(t, []string{"1.2.0rc1 1.2.0-RC1"}, lines)
+}
+
+func TestRunHook_InvalidTemplate(t *testing.T) {
+	hook := Hook{Command: "echo {{ .Nope }}", Type: PreTag}
+
+	result := RunHook(context.Background(), hook, &HookContext{Version: "1.0.0", Templates: true})
+
+	assert.False(t, result.Success)
+	assert.Error(t, result.Error)
+}
+
+func TestRunHookStreaming_TemplatesOff(t *testing.T) {
+	// Template syntax meant for another tool is passed through as written
+	hook := Hook{Command: "echo '{{.Id}}' $BUMPKIN_VERSION", Type: PostTag}
+
+	lineChan, doneChan := RunHookStreaming(
+		context.Background(), hook, &HookContext{Version: "1.0.0"},
+	)
+	result := <-doneChan
+	require.True(t, result.Success)
+
+	var lines []string
+	for line := range lineChan {
+		lines = append(lines, line.Text)
+	}
+	assert.Equal(t, []string{"{{.Id}} 1.0.0"}, lines)
+}
+
 // T004: Test for PostPush HookType constant
 func TestPostPushHookType(t *testing.T) {
 	assert.Equal(t, HookType("post-push"), PostPush)
@@ -1,6 +1,10 @@
 package hooks
 
-import "time"
+import (
+	"time"
+
+	"github.com/benny123tw/bumpkin/internal/version"
+)
 
 // StreamType identifies the source stream of hook output
 type StreamType int
@@ -67,12 +71,15 @@ type HookContext struct {
 	Remote          string
 	CommitHash      string
 	DryRun          bool
+	Templates       bool // If true, commands are expanded with Expand before they run
 }
 
 // ToEnv converts hook context to environment variables
 func (c *HookContext) ToEnv() []string {
 	return []string{
 		"BUMPKIN_VERSION=" + c.Version,
+		"BUMPKIN_VERSION_PEP440=" + c.convert(version.PEP440),
+		"BUMPKIN_VERSION_MAVEN=" + c.convert(version.Maven),
 		"BUMPKIN_PREVIOUS_VERSION=" + c.PreviousVersion,
 		"BUMPKIN_TAG=" + c.TagName,
 		"BUMPKIN_PREFIX=" + c.Prefix,
@@ -85,6 +92,26 @@ func (c *HookContext) ToEnv() []string {
 	}
 }
 
+// Expand renders template actions in a hook command with the context as data,
+// e.g. {{ pep440 .Version }} (see version.TemplateFuncs). Unless Templates is
+// set the command is returned as written.
+func (c *HookContext) Expand(command string) (string, error) {
+	if c == nil || !c.Templates {
+		return command, nil
+	}
+	return version.Render(command, c)
+}
+
+// convert returns the version in another format, or the version unchanged if
+// it is not semver (e.g., a calendar version with zero-padded segments)
+func (c *HookContext) convert(format func(version.Version) string) string {
+	v, err := version.Parse(c.Version)
+	if err != nil {
+		return c.Version
+	}
+	return format(v)
+}
+
 func boolToString(b bool) string {
 	if b {
 		return "true"
@@ -69,6 +69,12 @@ func RenderSuccess(result *ExecutionSummary) string {
 
 	fmt.Fprintf(&sb, "  Created tag: %s\n", NewVersionStyle.Render(result.TagName))
 	fmt.Fprintf(&sb, "  Commit:      %s\n", CommitHashStyle.Render(result.CommitHash[:7]))
+	if len(result.FloatingTags) > 0 {
+		fmt.Fprintf(&sb, "  Moved tags:  %s\n", strings.Join(result.FloatingTags, ", "))
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(&sb, "  %s\n", WarningStyle.Render("Warning: "+warning))
+	}
 
 	if result.Pushed {
 		fmt.Fprintf(&sb, "  Pushed to:   %s\n", result.Remote)
@@ -76,6 +82,10 @@ func RenderSuccess(result *ExecutionSummary) string {
 		fmt.Fprintf(&sb, "  Push:        %s\n", WarningStyle.Render("skipped"))
 	}
 
+	for _, warning := range result.PushWarnings {
+		fmt.Fprintf(&sb, "  %s\n", WarningStyle.Render("Warning: "+warning))
+	}
+
 	// Display post-push hook warnings if any
 	if len(result.PostPushWarnings) > 0 {
 		sb.WriteString("\n")
@@ -111,5 +121,8 @@ type ExecutionSummary struct {
 	CommitHash       string
 	Pushed           bool
 	Remote           string
+	FloatingTags     []string
+	Warnings         []string // Release tags that failed signature verification
 	PostPushWarnings []string
+	PushWarnings     []string // Best-effort remotes that were not pushed
 }
@@ -27,6 +27,7 @@ const (
 
 // RepoLoadedMsg is sent when repository info is loaded
 type RepoLoadedMsg struct {
+	LatestTag      *git.Tag
 	CurrentVersion *version.Version
 	Commits        []*git.Commit
 	HasRemote      bool
@@ -96,9 +97,15 @@ type HookPhaseCompleteMsg struct {
 
 // TagCreatedMsg is sent when the git tag has been created
 type TagCreatedMsg struct {
-	TagName    string
-	CommitHash string
+	TagName          string
+	CommitHash       string
+	VersionCommitted bool     // A release commit updated the version file
+	FloatingTags     []string // Floating tags moved to the release
+	Warnings         []string // Release tags that failed signature verification
 }
 
 // PushCompleteMsg is sent when the tag has been pushed to remote
-type PushCompleteMsg struct{}
+type PushCompleteMsg struct {
+	Remotes  []executor.RemoteResult // Outcome of the push to each remote
+	Warnings []string                // Best-effort remotes that were not pushed
+}
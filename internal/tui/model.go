@@ -10,6 +10,7 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/go-git/go-git/v5/plumbing"
 
 	"github.com/benny123tw/bumpkin/internal/conventional"
 	"github.com/benny123tw/bumpkin/internal/executor"
@@ -31,6 +32,9 @@ const (
 // Config contains configuration for the TUI
 type Config struct {
 	Repository    *git.Repository
+	Channels      version.Channels // Ordered prerelease channels (default: alpha, beta, rc)
+	Compat        bool             // Read legacy prereleases like rc1 as rc.1
+	VersionFile   executor.VersionFile
 	Prefix        string
 	Remote        string
 	DryRun        bool
@@ -39,6 +43,16 @@ type Config struct {
 	PreTagHooks   []string
 	PostTagHooks  []string
 	PostPushHooks []string
+	HookTemplates bool // Expand hook commands as Go templates
+
+	BuildMetadata  string                 // Build metadata or template, e.g. {{.ShortCommit}}
+	MetadataPolicy version.MetadataPolicy // Where build metadata goes (default: annotation)
+	Floating       bool                   // Move floating tags like v1 and v1.4 to stable releases
+	Message        string                 // Tag message template (default: "Release <version>")
+	Verifier       git.Verifier           // If set, verify the signatures of earlier release tags
+	RequireSigned  bool                   // If true, unverified release tags abort the release
+
+	Remotes []executor.PushRemote // Remotes to push to (default: Remote, required)
 }
 
 // Model is the main TUI model
@@ -48,6 +62,7 @@ type Model struct {
 	err    error
 
 	// Repository state
+	latestTag       *git.Tag
 	currentVersion  *version.Version
 	commits         []*git.Commit
 	hasRemote       bool
@@ -63,7 +78,10 @@ type Model struct {
 
 	// Selected bump
 	selectedBumpType version.BumpType
+	promote          bool // Tag the latest prerelease's commit instead of HEAD
 	newVersion       string
+	buildMetadata    string   // Build metadata kept out of the tag name, for the annotation
+	verifyWarnings   []string // Earlier release tags that failed signature verification
 
 	// Execution result
 	result *executor.Result
@@ -163,6 +181,7 @@ func (m Model) loadRepository() tea.Msg {
 	hasRemote, _ := m.config.Repository.HasRemote(m.config.Remote)
 
 	return RepoLoadedMsg{
+		LatestTag:      latestTag,
 		CurrentVersion: currentVersion,
 		Commits:        commits,
 		HasRemote:      hasRemote,
@@ -209,6 +228,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, cmd
 
 	case RepoLoadedMsg:
+		m.latestTag = msg.LatestTag
 		m.currentVersion = msg.CurrentVersion
 		m.commits = msg.Commits
 		m.hasRemote = msg.HasRemote
@@ -219,18 +239,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			commitMessages = append(commitMessages, c.Message)
 		}
 		analysis := conventional.AnalyzeCommits(commitMessages)
-		m.recommendedBump = analysis.RecommendedBump
+		m.recommendedBump = m.scheme().Recommend(analysis.RecommendedBump)
 
 		// Create version options with recommendation
+		base := *m.currentVersion
+		if m.config.Compat && base.IsPrerelease() {
+			base.Prerelease = version.NormalizePrerelease(base.Prerelease)
+		}
+
+		// A prerelease on a configured channel stays on that channel
+		channels := m.config.Channels
+		if len(channels) == 0 {
+			channels = version.DefaultChannels()
+		}
+		if base.IsPrerelease() && m.scheme().Supports(version.BumpPrereleaseChannel) &&
+			channels.Contains(base.PrereleaseType()) {
+			m.recommendedBump = analysis.RecommendedPrerelease(base)
+		}
 		m.versionOptions = CreateVersionOptionsWithRecommendation(
-			*m.currentVersion,
+			base,
 			m.config.Prefix,
+			m.config.Channels,
+			m.scheme(),
 			m.recommendedBump,
 		)
 
 		// Pre-select the recommended option
 		for i, opt := range m.versionOptions {
-			if opt.BumpType == m.recommendedBump {
+			if opt.IsRecommended {
 				m.selectedOption = i
 				break
 			}
@@ -296,9 +332,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case TagCreatedMsg:
 		// Store result from message
 		m.result = &executor.Result{
-			TagName:    msg.TagName,
-			CommitHash: msg.CommitHash,
-			Pushed:     false,
+			TagName:          msg.TagName,
+			CommitHash:       msg.CommitHash,
+			VersionCommitted: msg.VersionCommitted,
+			FloatingTags:     msg.FloatingTags,
+			Warnings:         msg.Warnings,
+			Pushed:           false,
 		}
 		// Tag created, run post-tag hooks
 		return m, m.startPostTagHooks()
@@ -307,11 +346,28 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Mark as pushed
 		if m.result != nil {
 			m.result.Pushed = true
+			m.result.Remotes = msg.Remotes
+			m.result.PushWarnings = msg.Warnings
 		}
 		// Push complete, run post-push hooks
 		return m, m.startPostPushHooks()
 
 	case ExecuteStartMsg:
+		if err := m.applyBuildMetadata(); err != nil {
+			m.err = err
+			m.state = StateError
+			return m, nil
+		}
+		if err := m.checkSignatures(); err != nil {
+			m.err = err
+			m.state = StateError
+			return m, nil
+		}
+		if err := executor.CheckVersionFile(m.config.Repository, m.versionFile()); err != nil {
+			m.err = err
+			m.state = StateError
+			return m, nil
+		}
 		// Start the execution flow with pre-tag hooks
 		return m, m.startPreTagHooks()
 	}
@@ -520,6 +576,7 @@ func (m Model) handleVersionSelectKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case keyEnter, keySpace:
 		selected := m.versionOptions[m.selectedOption]
 		m.selectedBumpType = selected.BumpType
+		m.promote = selected.Promote
 
 		if selected.BumpType == version.BumpCustom {
 			m.state = StateCustomInput
@@ -543,12 +600,26 @@ func (m Model) handleCustomInputKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 
 		// Validate version
-		_, err := version.Parse(customVer)
+		parsed, err := m.scheme().Parse(customVer)
 		if err != nil {
 			m.err = fmt.Errorf("invalid version: %s", customVer)
 			return m, nil
 		}
 
+		// Refuse downgrades and versions that are already tagged
+		if m.config.Repository != nil {
+			check, err := executor.CheckVersion(
+				m.config.Repository, m.scheme(), m.config.Prefix, m.latestTag, parsed,
+			)
+			if err == nil {
+				err = check.Err()
+			}
+			if err != nil {
+				m.err = err
+				return m, nil
+			}
+		}
+
 		// Add prefix if not present
 		if !strings.HasPrefix(customVer, m.config.Prefix) {
 			m.newVersion = m.config.Prefix + customVer
@@ -666,7 +737,9 @@ func (m Model) renderVersionSelectView() string {
 	var sb strings.Builder
 
 	fmt.Fprintf(&sb, "Current version: %s\n\n",
-		CurrentVersionStyle.Render(m.currentVersion.StringWithPrefix(m.config.Prefix)),
+		CurrentVersionStyle.Render(
+			version.FormatWithPrefix(m.scheme(), *m.currentVersion, m.config.Prefix),
+		),
 	)
 
 	// Dual-pane layout: commits pane (top) + version pane (bottom)
@@ -746,7 +819,7 @@ func (m Model) renderCustomInputView() string {
 
 func (m Model) renderConfirmView() string {
 	return RenderConfirmation(
-		m.currentVersion.String(),
+		m.scheme().Format(*m.currentVersion),
 		strings.TrimPrefix(m.newVersion, m.config.Prefix),
 		m.newVersion,
 		len(m.commits),
@@ -801,7 +874,19 @@ func (m Model) renderDoneView() string {
 		CommitHash:       m.result.CommitHash,
 		Pushed:           m.result.Pushed,
 		Remote:           m.config.Remote,
+		FloatingTags:     m.result.FloatingTags,
+		Warnings:         m.result.Warnings,
 		PostPushWarnings: m.result.PostPushWarnings,
+		PushWarnings:     m.result.PushWarnings,
+	}
+	if len(m.result.Remotes) > 1 {
+		var pushed []string
+		for _, remote := range m.result.Remotes {
+			if remote.Pushed {
+				pushed = append(pushed, remote.Remote)
+			}
+		}
+		summary.Remote = strings.Join(pushed, ", ")
 	}
 
 	return RenderSuccess(summary)
@@ -842,6 +927,14 @@ func waitForHookLine(lineChan chan hooks.OutputLine) tea.Cmd {
 	}
 }
 
+// scheme returns the version scheme the repository's tags are parsed with
+func (m Model) scheme() version.Scheme {
+	if m.config.Repository == nil {
+		return version.DefaultScheme()
+	}
+	return m.config.Repository.Scheme()
+}
+
 // waitForHookDone returns a tea.Cmd that waits for hook completion
 func waitForHookDone(doneChan chan hooks.HookResult) tea.Cmd {
 	return func() tea.Msg {
@@ -872,11 +965,12 @@ func (m *Model) startNextHook() tea.Cmd {
 	// Create hook context
 	hookCtx := &hooks.HookContext{
 		TagName:         m.newVersion,
-		PreviousVersion: m.currentVersion.String(),
-		Version:         strings.TrimPrefix(m.newVersion, m.config.Prefix),
+		PreviousVersion: m.scheme().Format(*m.currentVersion),
+		Version:         m.releaseVersion(),
 		Prefix:          m.config.Prefix,
 		Remote:          m.config.Remote,
 		DryRun:          m.config.DryRun,
+		Templates:       m.config.HookTemplates,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -1024,34 +1118,183 @@ func (m *Model) startPostPushHooks() tea.Cmd {
 	return m.startNextHook()
 }
 
+// targetHash returns the commit to tag: the latest prerelease's commit when
+// promoting, otherwise HEAD
+func (m Model) targetHash() (plumbing.Hash, error) {
+	if m.promote && m.latestTag != nil {
+		return plumbing.NewHash(m.latestTag.CommitHash), nil
+	}
+	head, err := m.config.Repository.GetHEAD()
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to get HEAD: %w", err)
+	}
+	return head, nil
+}
+
+// applyBuildMetadata renders the configured build metadata for the commit
+// being tagged. With the tag policy it becomes part of the tag name,
+// otherwise it is kept for the annotation and hooks.
+func (m *Model) applyBuildMetadata() error {
+	if m.config.BuildMetadata == "" {
+		return nil
+	}
+
+	target, err := m.targetHash()
+	if err != nil {
+		return err
+	}
+	metadata, err := executor.RenderMetadata(m.config.Repository, m.config.BuildMetadata, target)
+	if err != nil {
+		return err
+	}
+
+	tagName, _, _ := strings.Cut(m.newVersion, "+")
+	if m.config.MetadataPolicy == version.MetadataInTag {
+		m.newVersion = tagName + "+" + metadata
+	} else {
+		m.newVersion = tagName
+		m.buildMetadata = metadata
+	}
+	return nil
+}
+
+// checkSignatures verifies the release tags reachable from the commit being
+// tagged before any hook runs. Failures abort the release when signatures
+// are required, and are otherwise kept as warnings.
+func (m *Model) checkSignatures() error {
+	if m.config.Verifier == nil {
+		return nil
+	}
+
+	target, err := m.targetHash()
+	if err != nil {
+		return err
+	}
+	m.verifyWarnings, err = executor.CheckChain(
+		m.config.Repository,
+		m.config.Prefix,
+		target,
+		m.config.Verifier,
+		m.config.RequireSigned,
+	)
+	return err
+}
+
+// versionFile returns the version file to commit before tagging. Promotions
+// tag the prerelease's commit, so they leave the file alone.
+func (m Model) versionFile() executor.VersionFile {
+	if m.promote {
+		return executor.VersionFile{}
+	}
+	return m.config.VersionFile
+}
+
+// releaseVersion returns the new version without prefix, including build
+// metadata kept out of the tag name
+func (m Model) releaseVersion() string {
+	v := strings.TrimPrefix(m.newVersion, m.config.Prefix)
+	if m.buildMetadata != "" {
+		v += "+" + m.buildMetadata
+	}
+	return v
+}
+
 // doTagging creates the git tag
 func (m Model) doTagging() tea.Msg {
-	newVerStr := strings.TrimPrefix(m.newVersion, m.config.Prefix)
+	newVerStr := m.releaseVersion()
+	message := fmt.Sprintf("Release %s", strings.TrimPrefix(m.newVersion, m.config.Prefix))
 
-	// Get HEAD commit hash first
-	headHash, err := m.config.Repository.GetHEAD()
+	targetHash, err := m.targetHash()
 	if err != nil {
-		return ErrorMsg{Err: fmt.Errorf("failed to get HEAD: %w", err)}
+		return ErrorMsg{Err: err}
+	}
+	if m.config.Message != "" {
+		v, err := m.scheme().Parse(newVerStr)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		message, err = executor.TagMessage(
+			m.config.Repository, m.config.Message, m.config.Prefix, m.newVersion, v, targetHash,
+		)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+	}
+	if m.promote && m.latestTag != nil {
+		// Release the prerelease at the commit it was tagged on
+		if m.config.Message == "" {
+			message = executor.PromotionMessage(m.newVersion, m.latestTag)
+		} else {
+			message += "\n\nPromoted from " + m.latestTag.Name
+		}
 	}
+	message = executor.MetadataMessage(message, m.buildMetadata)
 
 	if m.config.DryRun {
 		// Dry run - just pretend we created the tag
 		return TagCreatedMsg{
 			TagName:    m.newVersion,
-			CommitHash: headHash.String(),
+			CommitHash: targetHash.String(),
+			Warnings:   m.verifyWarnings,
 		}
 	}
 
-	// Create the tag
-	err = m.config.Repository.CreateTag(m.newVersion, fmt.Sprintf("Release %s", newVerStr))
+	// Commit the version file so the tagged source matches the tag, and tag
+	// the release commit; a failed tag drops the release commit again
+	targetHash, versionCommitted, err := executor.TagRelease(
+		context.Background(),
+		m.config.Repository,
+		m.versionFile(),
+		newVerStr,
+		m.newVersion,
+		message,
+		targetHash,
+	)
+	if err != nil {
+		return ErrorMsg{Err: err}
+	}
+
+	floating, err := m.moveFloatingTags(newVerStr, targetHash)
 	if err != nil {
-		return ErrorMsg{Err: fmt.Errorf("failed to create tag: %w", err)}
+		return ErrorMsg{Err: err}
+	}
+
+	warnings := m.verifyWarnings
+	if w := executor.LightweightWarning(
+		m.config.Repository, m.config.Message, "", m.buildMetadata,
+	); w != "" {
+		warnings = append(warnings, w)
 	}
 
 	return TagCreatedMsg{
-		TagName:    m.newVersion,
-		CommitHash: headHash.String(),
+		TagName:          m.newVersion,
+		CommitHash:       targetHash.String(),
+		VersionCommitted: versionCommitted,
+		FloatingTags:     floating,
+		Warnings:         warnings,
+	}
+}
+
+// moveFloatingTags moves floating tags like v1 and v1.4 to a stable release
+func (m Model) moveFloatingTags(newVersion string, hash plumbing.Hash) ([]string, error) {
+	if !m.config.Floating || !m.scheme().Supports(version.BumpMinor) {
+		return nil, nil
+	}
+
+	v, err := m.scheme().Parse(newVersion)
+	if err != nil {
+		return nil, err
+	}
+	floating, err := executor.FloatingTags(m.config.Repository, m.config.Prefix, v)
+	if err != nil {
+		return nil, err
 	}
+	for _, name := range floating {
+		if err := m.config.Repository.MoveTag(name, hash); err != nil {
+			return nil, fmt.Errorf("failed to move floating tag: %w", err)
+		}
+	}
+	return floating, nil
 }
 
 // doPushAndPostPush pushes the tag and starts post-push hooks
@@ -1060,13 +1303,31 @@ func (m Model) doPushAndPostPush() tea.Msg {
 		return PushCompleteMsg{}
 	}
 
-	// Push the tag
-	err := m.config.Repository.PushTag(context.Background(), m.newVersion, m.config.Remote)
+	// Push the release commit and the tag together
+	var branch string
+	if m.result != nil && m.result.VersionCommitted {
+		var err error
+		branch, err = m.config.Repository.GetCurrentBranch()
+		if err != nil {
+			return ErrorMsg{Err: fmt.Errorf("failed to push release: %w", err)}
+		}
+	}
+	remotes := m.config.Remotes
+	if len(remotes) == 0 {
+		remotes = []executor.PushRemote{{Name: m.config.Remote}}
+	}
+	var floatingTags []string
+	if m.result != nil {
+		floatingTags = m.result.FloatingTags
+	}
+	results, warnings, err := executor.PushRemotes(
+		context.Background(), m.config.Repository, remotes, branch, m.newVersion, floatingTags,
+	)
 	if err != nil {
-		return ErrorMsg{Err: fmt.Errorf("failed to push tag: %w", err)}
+		return ErrorMsg{Err: err}
 	}
 
-	return PushCompleteMsg{}
+	return PushCompleteMsg{Remotes: results, Warnings: warnings}
 }
 
 // Run starts the TUI
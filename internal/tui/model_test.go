@@ -1,11 +1,15 @@
 package tui
 
 import (
+	"os/exec"
+	"path/filepath"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/benny123tw/bumpkin/internal/executor"
 	"github.com/benny123tw/bumpkin/internal/git"
 	"github.com/benny123tw/bumpkin/internal/version"
 )
@@ -364,3 +368,119 @@ func TestCommitsPaneVisibleWhenVersionPaneFocused(t *testing.T) {
 	assert.Contains(t, view, "Commits", "Commits pane header should be visible")
 	assert.Contains(t, view, "Version", "Version pane header should be visible")
 }
+
+// initTaggedRepo creates a repository with one commit tagged v1.0.0
+func initTaggedRepo(t *testing.T, dir string) {
+	t.Helper()
+	for _, args := range [][]string{
+		{"init"},
+		{"config", "user.email", "test@test.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "initial"},
+		{"tag", "-a", "v1.0.0", "-m", "Release v1.0.0"},
+	} {
+		cmd := exec.CommandContext(t.Context(), "git", args...)
+		cmd.Dir = dir
+		require.NoError(t, cmd.Run())
+	}
+}
+
+func TestExecuteStart_VerifiesReleaseTags(t *testing.T) {
+	tmpDir := t.TempDir()
+	initTaggedRepo(t, tmpDir)
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+	verifier, err := git.NewVerifier([]byte{}, nil)
+	require.NoError(t, err)
+
+	cfg := Config{
+		Repository: repo,
+		Prefix:     "v",
+		Verifier:   verifier,
+	}
+
+	// Unsigned tags are reported as warnings
+	updated, _ := New(cfg).Update(ExecuteStartMsg{})
+	model := updated.(Model)
+	assert.NotEqual(t, StateError, model.state)
+	require.Len(t, model.verifyWarnings, 1)
+	assert.Contains(t, model.verifyWarnings[0], "v1.0.0")
+
+	// And abort the release before any hook runs when signatures are required
+	cfg.RequireSigned = true
+	updated, _ = New(cfg).Update(ExecuteStartMsg{})
+	model = updated.(Model)
+	assert.Equal(t, StateError, model.state)
+	assert.ErrorIs(t, model.err, executor.ErrUntrustedTags)
+}
+
+func TestExecuteStart_BareRepositoryWithVersionFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	initTaggedRepo(t, tmpDir)
+	bareDir := filepath.Join(t.TempDir(), "bare.git")
+	cmd := exec.CommandContext(t.Context(), "git", "clone", "--bare", tmpDir, bareDir)
+	require.NoError(t, cmd.Run())
+
+	repo, err := git.Open(bareDir)
+	require.NoError(t, err)
+
+	updated, _ := New(Config{
+		Repository:  repo,
+		Prefix:      "v",
+		VersionFile: executor.VersionFile{Path: "version.go", Var: "Version", Package: "main"},
+	}).Update(ExecuteStartMsg{})
+	model := updated.(Model)
+	assert.Equal(t, StateError, model.state)
+	assert.ErrorIs(t, model.err, git.ErrBareRepository)
+}
+
+func TestDoTagging_DropsReleaseCommitWhenTagFails(t *testing.T) {
+	tmpDir := t.TempDir()
+	initTaggedRepo(t, tmpDir)
+
+	repo, err := git.Open(tmpDir)
+	require.NoError(t, err)
+	before, err := repo.GetHEAD()
+	require.NoError(t, err)
+
+	// v1.0.0 is already tagged, so the tag fails after the release commit
+	m := New(Config{
+		Repository:  repo,
+		Prefix:      "v",
+		VersionFile: executor.VersionFile{Path: "version.go", Var: "Version", Package: "main"},
+	})
+	m.newVersion = "v1.0.0"
+
+	msg, ok := m.doTagging().(ErrorMsg)
+	require.True(t, ok)
+	require.ErrorContains(t, msg.Err, "failed to create tag")
+
+	after, err := repo.GetHEAD()
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+	assert.NoFileExists(t, filepath.Join(tmpDir, "version.go"))
+}
+
+func TestRepoLoaded_RecommendsCurrentChannel(t *testing.T) {
+	current := version.Version{Major: 1, Minor: 2, Patch: 4, Prerelease: "beta.0"}
+	updated, _ := New(Config{Prefix: "v"}).Update(RepoLoadedMsg{
+		CurrentVersion: &current,
+		Commits:        []*git.Commit{{Message: "feat: login"}, {Message: "fix: typo"}},
+	})
+	model := updated.(Model)
+
+	// A feat on a patch prerelease starts the next minor on the same channel
+	selected := model.versionOptions[model.selectedOption]
+	assert.Equal(t, version.BumpPreminor, selected.BumpType)
+	assert.Equal(t, "v1.3.0-beta.0", selected.NewVersion)
+
+	// A fix continues the current prerelease
+	updated, _ = New(Config{Prefix: "v"}).Update(RepoLoadedMsg{
+		CurrentVersion: &current,
+		Commits:        []*git.Commit{{Message: "fix: typo"}},
+	})
+	model = updated.(Model)
+	selected = model.versionOptions[model.selectedOption]
+	assert.Equal(t, "v1.2.4-beta.1", selected.NewVersion)
+}
@@ -0,0 +1,146 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+// ErrCancelled is returned when the user quits a prompt without confirming
+var ErrCancelled = errors.New("cancelled by user")
+
+// PickerConfig holds the configuration for the commit picker
+type PickerConfig struct {
+	Title   string
+	Summary string        // Shown under the title, e.g. what will happen on confirm
+	Commits []*git.Commit // Newest first, as returned by the git package
+}
+
+// PickerModel lets the user select commits with space and confirm with enter
+type PickerModel struct {
+	config    PickerConfig
+	cursor    int
+	picked    map[int]bool
+	confirmed bool
+	cancelled bool
+}
+
+// NewPicker creates a new commit picker
+func NewPicker(cfg PickerConfig) PickerModel {
+	return PickerModel{
+		config: cfg,
+		picked: make(map[int]bool),
+	}
+}
+
+// Init initializes the picker
+func (m PickerModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles key presses
+func (m PickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "ctrl+c", "q", "esc":
+		m.cancelled = true
+		return m, tea.Quit
+	case keyUp, keyK:
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case keyDown, keyJ:
+		if m.cursor < len(m.config.Commits)-1 {
+			m.cursor++
+		}
+	case keySpace:
+		if len(m.config.Commits) > 0 {
+			m.picked[m.cursor] = !m.picked[m.cursor]
+		}
+	case keyEnter:
+		m.confirmed = true
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// View renders the picker
+func (m PickerModel) View() string {
+	if m.confirmed || m.cancelled {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(TitleStyle.Render(m.config.Title))
+	sb.WriteString("\n")
+	if m.config.Summary != "" {
+		sb.WriteString(SubtitleStyle.Render(m.config.Summary))
+		sb.WriteString("\n")
+	}
+
+	if len(m.config.Commits) == 0 {
+		sb.WriteString(MutedStyle.Render("  No commits to cherry-pick"))
+		sb.WriteString("\n")
+	}
+
+	for i, c := range m.config.Commits {
+		check := " "
+		if m.picked[i] {
+			check = IconCheck
+		}
+
+		cursor := " "
+		subject := UnselectedStyle.Render(c.Subject)
+		if i == m.cursor {
+			cursor = IconSelected
+			subject = SelectedStyle.Render(c.Subject)
+		}
+
+		fmt.Fprintf(&sb, "%s [%s] %s %s\n",
+			cursor,
+			SuccessStyle.Render(check),
+			CommitHashStyle.Render(c.ShortHash),
+			subject,
+		)
+	}
+
+	sb.WriteString(HelpStyle.Render("space: pick • enter: confirm • q: cancel"))
+	return sb.String()
+}
+
+// Picked returns the picked commits, oldest first so they can be applied in order
+func (m PickerModel) Picked() []*git.Commit {
+	var picked []*git.Commit
+	for i := len(m.config.Commits) - 1; i >= 0; i-- {
+		if m.picked[i] {
+			picked = append(picked, m.config.Commits[i])
+		}
+	}
+	return picked
+}
+
+// RunPicker runs the commit picker and returns the picked commits.
+// Returns ErrCancelled if the user quits without confirming.
+func RunPicker(cfg PickerConfig) ([]*git.Commit, error) {
+	final, err := tea.NewProgram(NewPicker(cfg)).Run()
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := final.(PickerModel)
+	if !ok || !m.confirmed {
+		return nil, ErrCancelled
+	}
+
+	return m.Picked(), nil
+}
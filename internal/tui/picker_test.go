@@ -0,0 +1,62 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benny123tw/bumpkin/internal/git"
+)
+
+func pickerKey(m PickerModel, key string) PickerModel {
+	var msg tea.KeyMsg
+	switch key {
+	case keyEnter:
+		msg = tea.KeyMsg{Type: tea.KeyEnter}
+	case keySpace:
+		msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	default:
+		msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+	}
+	updated, _ := m.Update(msg)
+	return updated.(PickerModel)
+}
+
+func TestPicker_PicksOldestFirst(t *testing.T) {
+	commits := []*git.Commit{
+		{ShortHash: "ccc", Subject: "fix: third"},
+		{ShortHash: "bbb", Subject: "fix: second"},
+		{ShortHash: "aaa", Subject: "fix: first"},
+	}
+	m := NewPicker(PickerConfig{Title: "Hotfix", Commits: commits})
+
+	m = pickerKey(m, keySpace)
+	m = pickerKey(m, keyJ)
+	m = pickerKey(m, keyJ)
+	m = pickerKey(m, keySpace)
+
+	assert.Contains(t, m.View(), "fix: second")
+
+	m = pickerKey(m, keyEnter)
+	require.True(t, m.confirmed)
+
+	picked := m.Picked()
+	require.Len(t, picked, 2)
+	assert.Equal(t, "aaa", picked[0].ShortHash)
+	assert.Equal(t, "ccc", picked[1].ShortHash)
+}
+
+func TestPicker_ToggleAndCancel(t *testing.T) {
+	commits := []*git.Commit{{ShortHash: "aaa", Subject: "fix: first"}}
+	m := NewPicker(PickerConfig{Title: "Hotfix", Commits: commits})
+
+	m = pickerKey(m, keySpace)
+	m = pickerKey(m, keySpace)
+	assert.Empty(t, m.Picked())
+
+	m = pickerKey(m, "q")
+	assert.True(t, m.cancelled)
+	assert.False(t, m.confirmed)
+}
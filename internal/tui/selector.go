@@ -12,35 +12,52 @@ type VersionOption struct {
 	Label         string
 	Description   string
 	BumpType      version.BumpType
+	Channel       string // Prerelease channel for BumpPrereleaseChannel options
+	Promote       bool   // Release at the prerelease's own commit instead of HEAD
 	NewVersion    string
 	IsRecommended bool
 }
 
-// CreateVersionOptions creates version options based on current version
-func CreateVersionOptions(current version.Version, prefix string) []VersionOption {
-	options := []VersionOption{
-		{
-			Label:       "patch",
-			Description: "Bug fixes, backwards compatible",
-			BumpType:    version.BumpPatch,
-			NewVersion:  version.Bump(current, version.BumpPatch).StringWithPrefix(prefix),
-		},
-		{
-			Label:       "minor",
-			Description: "New features, backwards compatible",
-			BumpType:    version.BumpMinor,
-			NewVersion:  version.Bump(current, version.BumpMinor).StringWithPrefix(prefix),
-		},
-		{
-			Label:       "major",
-			Description: "Breaking changes",
-			BumpType:    version.BumpMajor,
-			NewVersion:  version.Bump(current, version.BumpMajor).StringWithPrefix(prefix),
-		},
+// CreateVersionOptions creates version options based on current version. Schemes
+// without minor bumps (calver) get a single option for the next version.
+func CreateVersionOptions(
+	current version.Version,
+	prefix string,
+	channels version.Channels,
+	scheme version.Scheme,
+) []VersionOption {
+	if scheme == nil {
+		scheme = version.DefaultScheme()
+	}
+	if !scheme.Supports(version.BumpMinor) {
+		return createSchemeOptions(current, prefix, scheme)
+	}
+
+	levels := []struct {
+		bumpType    version.BumpType
+		description string
+	}{
+		{version.BumpPatch, "Bug fixes, backwards compatible"},
+		{version.BumpMinor, "New features, backwards compatible"},
+		{version.BumpMajor, "Breaking changes"},
+	}
+	options := make([]VersionOption, 0, len(levels))
+	for _, level := range levels {
+		next, err := version.Bump(current, level.bumpType)
+		if err != nil {
+			continue
+		}
+		options = append(options, VersionOption{
+			Label:       level.bumpType.String(),
+			Description: level.description,
+			BumpType:    level.bumpType,
+			NewVersion:  next.StringWithPrefix(prefix),
+		})
 	}
 
 	// Add prerelease options
-	options = append(options, createPrereleaseOptions(current, prefix)...)
+	options = append(options, createPrereleaseOptions(current, prefix, channels)...)
+	options = append(options, createPreLevelOptions(current, prefix, channels)...)
 
 	// Add custom option at the end
 	options = append(options, VersionOption{
@@ -53,54 +70,69 @@ func CreateVersionOptions(current version.Version, prefix string) []VersionOptio
 	return options
 }
 
-// createPrereleaseOptions creates prerelease version options
-func createPrereleaseOptions(current version.Version, prefix string) []VersionOption {
+// createSchemeOptions creates the options for a scheme that only supports
+// moving to its next version, plus a custom version
+func createSchemeOptions(
+	current version.Version,
+	prefix string,
+	scheme version.Scheme,
+) []VersionOption {
+	var options []VersionOption
+
+	// The next version is left out when the scheme can't produce one,
+	// e.g. a calver layout without MICRO that was already released today
+	if next, err := scheme.Bump(current, version.BumpPatch); err == nil {
+		options = append(options, VersionOption{
+			Label:       "next",
+			Description: fmt.Sprintf("Next %s version", scheme.Name()),
+			BumpType:    version.BumpPatch,
+			NewVersion:  version.FormatWithPrefix(scheme, next, prefix),
+		})
+	}
+
+	return append(options, VersionOption{
+		Label:       "custom",
+		Description: "Enter a custom version",
+		BumpType:    version.BumpCustom,
+		NewVersion:  "...",
+	})
+}
+
+// createPrereleaseOptions creates prerelease version options driven by the
+// configured channel order
+func createPrereleaseOptions(
+	current version.Version,
+	prefix string,
+	channels version.Channels,
+) []VersionOption {
+	if len(channels) == 0 {
+		channels = version.DefaultChannels()
+	}
+
 	var options []VersionOption
 
 	// If current version is a prerelease, show relevant options
 	if current.IsPrerelease() {
 		preType := current.PrereleaseType()
 
-		// Show option to increment current prerelease type
-		switch preType {
-		case "alpha":
-			options = append(options, VersionOption{
-				Label:       "alpha",
-				Description: "Increment alpha version",
-				BumpType:    version.BumpPrereleaseAlpha,
-				NewVersion: version.Bump(current, version.BumpPrereleaseAlpha).
-					StringWithPrefix(prefix),
-			})
-			options = append(options, VersionOption{
-				Label:       "beta",
-				Description: "Promote to beta",
-				BumpType:    version.BumpPrereleaseBeta,
-				NewVersion: version.Bump(current, version.BumpPrereleaseBeta).
-					StringWithPrefix(prefix),
-			})
-		case "beta":
-			options = append(options, VersionOption{
-				Label:       "beta",
-				Description: "Increment beta version",
-				BumpType:    version.BumpPrereleaseBeta,
-				NewVersion: version.Bump(current, version.BumpPrereleaseBeta).
-					StringWithPrefix(prefix),
-			})
-			options = append(options, VersionOption{
-				Label:       "rc",
-				Description: "Promote to release candidate",
-				BumpType:    version.BumpPrereleaseRC,
-				NewVersion: version.Bump(current, version.BumpPrereleaseRC).
-					StringWithPrefix(prefix),
-			})
-		case "rc":
-			options = append(options, VersionOption{
-				Label:       "rc",
-				Description: "Increment release candidate",
-				BumpType:    version.BumpPrereleaseRC,
-				NewVersion: version.Bump(current, version.BumpPrereleaseRC).
-					StringWithPrefix(prefix),
-			})
+		if channels.Contains(preType) {
+			// Show option to increment current channel, then the next one
+			options = appendChannelOption(options,
+				current, prefix, preType,
+				fmt.Sprintf("Increment %s version", preType),
+			)
+			if next, ok := channels.Next(preType); ok {
+				options = appendChannelOption(options,
+					current, prefix, next,
+					fmt.Sprintf("Promote to %s", next),
+				)
+			}
+		} else {
+			// Channel not configured, offer to move onto the first configured one
+			options = appendChannelOption(options,
+				current, prefix, channels[0],
+				fmt.Sprintf("Switch to %s prerelease", channels[0]),
+			)
 		}
 
 		// Always show release option for prereleases
@@ -108,15 +140,59 @@ func createPrereleaseOptions(current version.Version, prefix string) []VersionOp
 			Label:       "release",
 			Description: "Promote to stable release",
 			BumpType:    version.BumpRelease,
-			NewVersion:  version.Bump(current, version.BumpRelease).StringWithPrefix(prefix),
+			NewVersion:  version.BumpToRelease(current).StringWithPrefix(prefix),
+		})
+		options = append(options, VersionOption{
+			Label:       "promote",
+			Description: "Release the prerelease commit as stable",
+			BumpType:    version.BumpRelease,
+			Promote:     true,
+			NewVersion:  version.BumpToRelease(current).StringWithPrefix(prefix),
 		})
 	} else {
-		// For stable releases, show alpha option
+		// For stable releases, show the first channel
+		options = appendChannelOption(options,
+			current, prefix, channels[0],
+			fmt.Sprintf("Start new %s prerelease", channels[0]),
+		)
+	}
+
+	return options
+}
+
+// createPreLevelOptions creates options that start a prerelease of the next
+// major or minor version (and patch, when already on a prerelease). They use
+// the current channel if it is configured, otherwise the first channel.
+func createPreLevelOptions(
+	current version.Version,
+	prefix string,
+	channels version.Channels,
+) []VersionOption {
+	if len(channels) == 0 {
+		channels = version.DefaultChannels()
+	}
+
+	channel := channels[0]
+	if channels.Contains(current.PrereleaseType()) {
+		channel = current.PrereleaseType()
+	}
+
+	// For stable versions, prepatch is the same as starting the first channel
+	levels := []version.BumpType{version.BumpPreminor, version.BumpPremajor}
+	if current.IsPrerelease() {
+		levels = append([]version.BumpType{version.BumpPrepatch}, levels...)
+	}
+
+	options := make([]VersionOption, 0, len(levels))
+	for _, bumpType := range levels {
 		options = append(options, VersionOption{
-			Label:       "alpha",
-			Description: "Start new alpha prerelease",
-			BumpType:    version.BumpPrereleaseAlpha,
-			NewVersion: version.Bump(current, version.BumpPrereleaseAlpha).
+			Label: bumpType.String(),
+			Description: fmt.Sprintf(
+				"Start %s prerelease of the next %s", channel, bumpType.Base(),
+			),
+			BumpType: bumpType,
+			Channel:  channel,
+			NewVersion: version.BumpPreLevel(current, bumpType, channel).
 				StringWithPrefix(prefix),
 		})
 	}
@@ -124,19 +200,50 @@ func createPrereleaseOptions(current version.Version, prefix string) []VersionOp
 	return options
 }
 
+// appendChannelOption appends a version option bumping to the given prerelease
+// channel. The option is left out when the current prerelease cannot be bumped
+// on that channel (e.g., a legacy rc1 without compat mode).
+func appendChannelOption(
+	options []VersionOption,
+	current version.Version,
+	prefix string,
+	channel string,
+	description string,
+) []VersionOption {
+	next, err := version.NextPrerelease(current, channel, false)
+	if err != nil {
+		return options
+	}
+
+	return append(options, VersionOption{
+		Label:       channel,
+		Description: description,
+		BumpType:    version.BumpPrereleaseChannel,
+		Channel:     channel,
+		NewVersion:  next.StringWithPrefix(prefix),
+	})
+}
+
 // CreateVersionOptionsWithRecommendation creates options with a recommended bump highlighted
 func CreateVersionOptionsWithRecommendation(
 	current version.Version,
 	prefix string,
+	channels version.Channels,
+	scheme version.Scheme,
 	recommended version.BumpType,
 ) []VersionOption {
-	options := CreateVersionOptions(current, prefix)
+	options := CreateVersionOptions(current, prefix, channels, scheme)
 
-	// Mark the recommended option
+	// Mark the recommended option; prerelease bumps stay on the current channel
 	for i := range options {
-		if options[i].BumpType == recommended {
-			options[i].IsRecommended = true
+		if options[i].BumpType != recommended {
+			continue
+		}
+		if recommended == version.BumpPrereleaseChannel &&
+			options[i].Channel != current.PrereleaseType() {
+			continue
 		}
+		options[i].IsRecommended = true
 	}
 
 	return options
@@ -0,0 +1,130 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benny123tw/bumpkin/internal/version"
+)
+
+func optionLabels(options []VersionOption) []string {
+	labels := make([]string, len(options))
+	for i, opt := range options {
+		labels[i] = opt.Label
+	}
+	return labels
+}
+
+func TestCreatePrereleaseOptions_DefaultChannels(t *testing.T) {
+	options := createPrereleaseOptions(version.Version{Major: 1}, "v", nil)
+
+	assert.Equal(t, []string{"alpha"}, optionLabels(options))
+	assert.Equal(t, "v1.0.1-alpha.0", options[0].NewVersion)
+}
+
+func TestCreatePrereleaseOptions_CustomChannels(t *testing.T) {
+	channels := version.Channels{"dev", "canary", "next", "preview"}
+	current := version.Version{Major: 1, Minor: 2, Patch: 0, Prerelease: "canary.3"}
+
+	options := createPrereleaseOptions(current, "v", channels)
+
+	assert.Equal(t, []string{"canary", "next", "release", "promote"}, optionLabels(options))
+	assert.Equal(t, "v1.2.0-canary.4", options[0].NewVersion)
+	assert.Equal(t, "v1.2.0-next.0", options[1].NewVersion)
+	assert.Equal(t, "next", options[1].Channel)
+	assert.Equal(t, version.BumpPrereleaseChannel, options[1].BumpType)
+}
+
+func TestCreatePrereleaseOptions_LastChannel(t *testing.T) {
+	channels := version.Channels{"dev", "canary", "next", "preview"}
+	current := version.Version{Major: 1, Prerelease: "preview.0"}
+
+	options := createPrereleaseOptions(current, "v", channels)
+
+	assert.Equal(t, []string{"preview", "release", "promote"}, optionLabels(options))
+}
+
+func TestCreatePrereleaseOptions_UnconfiguredChannel(t *testing.T) {
+	channels := version.Channels{"dev", "canary"}
+	current := version.Version{Major: 1, Prerelease: "beta.1"}
+
+	options := createPrereleaseOptions(current, "v", channels)
+
+	assert.Equal(t, []string{"dev", "release", "promote"}, optionLabels(options))
+	assert.Equal(t, "v1.0.0-dev.0", options[0].NewVersion)
+}
+
+func TestCreatePrereleaseOptions_Promote(t *testing.T) {
+	current := version.Version{Major: 1, Minor: 1, Prerelease: "rc.2"}
+
+	options := createPrereleaseOptions(current, "v", nil)
+
+	promote := options[len(options)-1]
+	assert.Equal(t, "promote", promote.Label)
+	assert.True(t, promote.Promote)
+	assert.Equal(t, version.BumpRelease, promote.BumpType)
+	assert.Equal(t, "v1.1.0", promote.NewVersion)
+}
+
+func TestCreatePreLevelOptions_Stable(t *testing.T) {
+	options := createPreLevelOptions(version.Version{Major: 1, Minor: 2, Patch: 3}, "v", nil)
+
+	assert.Equal(t, []string{"preminor", "premajor"}, optionLabels(options))
+	assert.Equal(t, "v1.3.0-alpha.0", options[0].NewVersion)
+	assert.Equal(t, "v2.0.0-alpha.0", options[1].NewVersion)
+	assert.Equal(t, "Start alpha prerelease of the next minor", options[0].Description)
+}
+
+func TestCreatePreLevelOptions_KeepsCurrentChannel(t *testing.T) {
+	current := version.Version{Major: 1, Minor: 3, Patch: 0, Prerelease: "beta.1"}
+
+	options := createPreLevelOptions(current, "v", nil)
+
+	assert.Equal(t, []string{"prepatch", "preminor", "premajor"}, optionLabels(options))
+	assert.Equal(t, "v1.3.1-beta.0", options[0].NewVersion)
+	assert.Equal(t, "v1.4.0-beta.0", options[1].NewVersion)
+	assert.Equal(t, "v2.0.0-beta.0", options[2].NewVersion)
+}
+
+func TestCreatePrereleaseOptions_SkipsUnbumpablePrerelease(t *testing.T) {
+	current := version.Version{Major: 1, Minor: 4, Prerelease: "alpha"}
+
+	options := createPrereleaseOptions(current, "v", nil)
+
+	// A bare "alpha" has no number to increment, so only promotion is offered
+	assert.Equal(t, []string{"beta", "release", "promote"}, optionLabels(options))
+}
+
+func TestCreateVersionOptions_CalVer(t *testing.T) {
+	scheme, err := version.NewCalVer("YYYY.0M.MICRO")
+	require.NoError(t, err)
+	scheme.Now = func() time.Time { return time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC) }
+
+	current := version.Version{Major: 2026, Minor: 10, Patch: 2}
+	options := CreateVersionOptionsWithRecommendation(
+		current, "v", nil, scheme, scheme.Recommend(version.BumpMajor),
+	)
+
+	assert.Equal(t, []string{"next", "custom"}, optionLabels(options))
+	assert.Equal(t, "v2026.10.3", options[0].NewVersion)
+	assert.True(t, options[0].IsRecommended)
+}
+
+func TestCreateVersionOptionsWithRecommendation_Prerelease(t *testing.T) {
+	current := version.Version{Major: 1, Minor: 3, Patch: 0, Prerelease: "beta.1"}
+	options := CreateVersionOptionsWithRecommendation(
+		current, "v", nil, nil, version.BumpPrereleaseChannel,
+	)
+
+	// Only the current channel is recommended, not the promotion to rc
+	var recommended []string
+	for _, opt := range options {
+		if opt.IsRecommended {
+			recommended = append(recommended, opt.NewVersion)
+		}
+	}
+	assert.Equal(t, []string{"v1.3.0-beta.2"}, recommended)
+}
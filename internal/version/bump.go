@@ -14,6 +14,10 @@ const (
 	BumpPrereleaseBeta
 	BumpPrereleaseRC
 	BumpRelease
+	BumpPrereleaseChannel // Prerelease on a channel given separately (see BumpPrerelease)
+	BumpPremajor          // Next major as a prerelease (1.2.3 -> 2.0.0-<channel>.0)
+	BumpPreminor          // Next minor as a prerelease (1.2.3 -> 1.3.0-<channel>.0)
+	BumpPrepatch          // Next patch as a prerelease (1.2.3 -> 1.2.4-<channel>.0)
 )
 
 // String returns the string representation of BumpType
@@ -35,11 +39,64 @@ func (b BumpType) String() string {
 		return "prerelease-rc"
 	case BumpRelease:
 		return "release"
+	case BumpPrereleaseChannel:
+		return "prerelease"
+	case BumpPremajor:
+		return "premajor"
+	case BumpPreminor:
+		return "preminor"
+	case BumpPrepatch:
+		return "prepatch"
 	default:
 		return "unknown"
 	}
 }
 
+// Channel returns the prerelease channel implied by the bump type
+// (alpha, beta or rc), or an empty string for other bump types
+func (b BumpType) Channel() string {
+	switch b {
+	case BumpPrereleaseAlpha:
+		return "alpha"
+	case BumpPrereleaseBeta:
+		return "beta"
+	case BumpPrereleaseRC:
+		return "rc"
+	default:
+		return ""
+	}
+}
+
+// Base returns the release-level bump behind a pre-level bump type
+// (BumpPremajor -> BumpMajor), or 0 for other bump types
+func (b BumpType) Base() BumpType {
+	switch b {
+	case BumpPremajor:
+		return BumpMajor
+	case BumpPreminor:
+		return BumpMinor
+	case BumpPrepatch:
+		return BumpPatch
+	default:
+		return 0
+	}
+}
+
+// PreLevel returns the pre-level bump type for a release-level bump
+// (BumpMajor -> BumpPremajor), or 0 for other bump types
+func (b BumpType) PreLevel() BumpType {
+	switch b {
+	case BumpMajor:
+		return BumpPremajor
+	case BumpMinor:
+		return BumpPreminor
+	case BumpPatch:
+		return BumpPrepatch
+	default:
+		return 0
+	}
+}
+
 // ParseBumpType parses a string into a BumpType
 func ParseBumpType(s string) (BumpType, error) {
 	switch s {
@@ -59,44 +116,52 @@ func ParseBumpType(s string) (BumpType, error) {
 		return BumpPrereleaseRC, nil
 	case "release":
 		return BumpRelease, nil
+	case "prerelease":
+		return BumpPrereleaseChannel, nil
+	case "premajor":
+		return BumpPremajor, nil
+	case "preminor":
+		return BumpPreminor, nil
+	case "prepatch":
+		return BumpPrepatch, nil
 	default:
 		return 0, fmt.Errorf("unknown bump type: %q", s)
 	}
 }
 
-// Bump applies the specified bump type to a version and returns the new version
-func Bump(v Version, bumpType BumpType) Version {
+// Bump applies the specified bump type to a version and returns the new
+// version. Prerelease bumps can fail on existing prereleases (see
+// BumpPrerelease); custom versions and BumpPrereleaseChannel, which need a
+// version or channel given separately, are reported as errors.
+func Bump(v Version, bumpType BumpType) (Version, error) {
 	switch bumpType {
-	case BumpPatch:
-		return Version{
-			Major: v.Major,
-			Minor: v.Minor,
-			Patch: v.Patch + 1,
-		}
-	case BumpMinor:
-		return Version{
-			Major: v.Major,
-			Minor: v.Minor + 1,
-			Patch: 0,
-		}
-	case BumpMajor:
-		return Version{
-			Major: v.Major + 1,
-			Minor: 0,
-			Patch: 0,
-		}
+	case BumpPatch, BumpMinor, BumpMajor:
+		return bumpLevel(v, bumpType), nil
 	case BumpRelease:
-		return BumpToRelease(v)
-	case BumpPrereleaseAlpha:
-		return BumpPrerelease(v, "alpha")
-	case BumpPrereleaseBeta:
-		return BumpPrerelease(v, "beta")
-	case BumpPrereleaseRC:
-		return BumpPrerelease(v, "rc")
+		return BumpToRelease(v), nil
+	case BumpPrereleaseAlpha, BumpPrereleaseBeta, BumpPrereleaseRC:
+		return BumpPrerelease(v, bumpType.Channel())
+	case BumpPremajor, BumpPreminor, BumpPrepatch:
+		// Without a channel, start on the first default channel
+		return BumpPreLevel(v, bumpType, DefaultChannels()[0]), nil
 	case BumpCustom:
-		// Custom versions are handled separately
-		return v
+		return v, fmt.Errorf("%w: %s needs a version", ErrUnsupportedBump, bumpType)
+	case BumpPrereleaseChannel:
+		return v, fmt.Errorf("%w: %s needs a channel", ErrUnsupportedBump, bumpType)
+	default:
+		return v, fmt.Errorf("%w: %s", ErrUnsupportedBump, bumpType)
+	}
+}
+
+// bumpLevel increments the major, minor or patch number, dropping any
+// prerelease and metadata
+func bumpLevel(v Version, bumpType BumpType) Version {
+	switch bumpType {
+	case BumpMajor:
+		return Version{Major: v.Major + 1}
+	case BumpMinor:
+		return Version{Major: v.Major, Minor: v.Minor + 1}
 	default:
-		return v
+		return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // T011: Test for BumpType enum and string representation
@@ -20,6 +21,10 @@ func TestBumpType_String(t *testing.T) {
 		{BumpPrereleaseBeta, "prerelease-beta"},
 		{BumpPrereleaseRC, "prerelease-rc"},
 		{BumpRelease, "release"},
+		{BumpPrereleaseChannel, "prerelease"},
+		{BumpPremajor, "premajor"},
+		{BumpPreminor, "preminor"},
+		{BumpPrepatch, "prepatch"},
 	}
 
 	for _, tt := range tests {
@@ -43,6 +48,10 @@ func TestParseBumpType(t *testing.T) {
 		{"prerelease-beta", BumpPrereleaseBeta, false},
 		{"prerelease-rc", BumpPrereleaseRC, false},
 		{"release", BumpRelease, false},
+		{"prerelease", BumpPrereleaseChannel, false},
+		{"premajor", BumpPremajor, false},
+		{"preminor", BumpPreminor, false},
+		{"prepatch", BumpPrepatch, false},
 		{"invalid", BumpType(0), true},
 		{"", BumpType(0), true},
 	}
@@ -132,12 +141,52 @@ func TestBump(t *testing.T) {
 			bumpType: BumpRelease,
 			expected: Version{Major: 1, Minor: 0, Patch: 1},
 		},
+		// Prerelease bumps
+		{
+			name:     "prerelease: starts channel after release",
+			input:    Version{Major: 1, Minor: 2, Patch: 3},
+			bumpType: BumpPrereleaseAlpha,
+			expected: Version{Major: 1, Minor: 2, Patch: 4, Prerelease: "alpha.0"},
+		},
+		{
+			name:     "prerelease: increments same channel",
+			input:    Version{Major: 1, Minor: 2, Patch: 4, Prerelease: "beta.1"},
+			bumpType: BumpPrereleaseBeta,
+			expected: Version{Major: 1, Minor: 2, Patch: 4, Prerelease: "beta.2"},
+		},
+		{
+			name:     "prerelease: moves to next channel",
+			input:    Version{Major: 1, Minor: 2, Patch: 4, Prerelease: "beta.1"},
+			bumpType: BumpPrereleaseRC,
+			expected: Version{Major: 1, Minor: 2, Patch: 4, Prerelease: "rc.0"},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := Bump(tt.input, tt.bumpType)
+			result, err := Bump(tt.input, tt.bumpType)
+			require.NoError(t, err)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
 }
+
+func TestBump_Errors(t *testing.T) {
+	v := Version{Major: 1, Minor: 2, Patch: 3}
+
+	for _, bumpType := range []BumpType{BumpCustom, BumpPrereleaseChannel} {
+		_, err := Bump(v, bumpType)
+		require.ErrorIs(t, err, ErrUnsupportedBump, bumpType.String())
+	}
+
+	// Prereleases without a number to increment are not restarted
+	_, err := Bump(Version{Major: 1, Minor: 2, Patch: 4, Prerelease: "rc"}, BumpPrereleaseRC)
+	assert.Error(t, err)
+}
+
+func TestBump_PreLevelDefaultsToFirstChannel(t *testing.T) {
+	v := Version{Major: 1, Minor: 2, Patch: 3}
+	next, err := Bump(v, BumpPreminor)
+	require.NoError(t, err)
+	assert.Equal(t, "1.3.0-alpha.0", next.String())
+}
@@ -0,0 +1,201 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultCalVerLayout is the calver layout used when none is configured
+const DefaultCalVerLayout = "YYYY.0M.MICRO"
+
+// calverTokens lists the supported layout segments, see https://calver.org
+var calverTokens = map[string]bool{
+	"YYYY": true, "YY": true, "0Y": true,
+	"MM": true, "0M": true,
+	"WW": true, "0W": true,
+	"DD": true, "0D": true,
+	"MICRO": true,
+}
+
+// CalVer is a calendar versioning scheme with a layout such as YYYY.0M.MICRO.
+// Up to three segments are stored in Major, Minor and Patch, in order.
+type CalVer struct {
+	tokens []string
+	// Now returns the current time; defaults to time.Now
+	Now func() time.Time
+}
+
+// NewCalVer creates a calver scheme from a dot-separated layout. Supported
+// segments are YYYY, YY, 0Y, MM, 0M, WW, 0W, DD, 0D and MICRO, which must be last.
+func NewCalVer(layout string) (*CalVer, error) {
+	tokens := strings.Split(layout, ".")
+	if len(tokens) > 3 {
+		return nil, fmt.Errorf("calver layout %q has more than three segments", layout)
+	}
+
+	for i, token := range tokens {
+		if !calverTokens[token] {
+			return nil, fmt.Errorf("unknown calver segment %q in %q", token, layout)
+		}
+		if token == "MICRO" && i != len(tokens)-1 {
+			return nil, fmt.Errorf("MICRO must be the last segment in %q", layout)
+		}
+	}
+	if tokens[0] == "MICRO" {
+		return nil, fmt.Errorf("calver layout %q has no date segment", layout)
+	}
+
+	return &CalVer{tokens: tokens}, nil
+}
+
+// Name returns "calver"
+func (c *CalVer) Name() string { return "calver" }
+
+// Layout returns the layout the scheme was created with
+func (c *CalVer) Layout() string { return strings.Join(c.tokens, ".") }
+
+// Parse parses a calendar version that matches the layout
+func (c *CalVer) Parse(s string) (Version, error) {
+	s = strings.TrimPrefix(s, "v")
+	s, metadata, _ := strings.Cut(s, "+")
+	if metadata != "" {
+		if err := ValidateMetadata(metadata); err != nil {
+			return Version{}, err
+		}
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) != len(c.tokens) {
+		return Version{}, fmt.Errorf("invalid version %q: expected layout %s", s, c.Layout())
+	}
+
+	values := make([]uint64, 3)
+	for i, part := range parts {
+		n, err := parseSegment(c.tokens[i], part)
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid version %q: %w", s, err)
+		}
+		values[i] = n
+	}
+
+	return Version{Major: values[0], Minor: values[1], Patch: values[2], Metadata: metadata}, nil
+}
+
+// Format returns the version laid out by the scheme, with zero padding and
+// any build metadata
+func (c *CalVer) Format(v Version) string {
+	values := []uint64{v.Major, v.Minor, v.Patch}
+	parts := make([]string, len(c.tokens))
+	for i, token := range c.tokens {
+		if strings.HasPrefix(token, "0") {
+			parts[i] = fmt.Sprintf("%02d", values[i])
+		} else {
+			parts[i] = strconv.FormatUint(values[i], 10)
+		}
+	}
+
+	s := strings.Join(parts, ".")
+	if v.Metadata != "" {
+		s += "+" + v.Metadata
+	}
+	return s
+}
+
+// Bump returns the version for today. MICRO restarts at 0 on a new date and
+// is incremented when releasing again on the same date.
+func (c *CalVer) Bump(v Version, t BumpType) (Version, error) {
+	if !c.Supports(t) || t == BumpCustom {
+		return Version{}, fmt.Errorf("%w: %s under calver", ErrUnsupportedBump, t)
+	}
+
+	now := time.Now
+	if c.Now != nil {
+		now = c.Now
+	}
+	today := now()
+
+	current := []uint64{v.Major, v.Minor, v.Patch}
+	next := make([]uint64, 3)
+	sameDate := true
+	for i, token := range c.tokens {
+		if token == "MICRO" {
+			continue
+		}
+		next[i] = dateSegment(token, today)
+		if next[i] != current[i] {
+			sameDate = false
+		}
+	}
+
+	last := len(c.tokens) - 1
+	if sameDate {
+		if c.tokens[last] != "MICRO" {
+			return Version{}, fmt.Errorf(
+				"version %s is already today's release; add MICRO to the calver layout",
+				c.Format(v),
+			)
+		}
+		next[last] = current[last] + 1
+	}
+
+	return Version{Major: next[0], Minor: next[1], Patch: next[2]}, nil
+}
+
+// Supports returns true for patch (the next calendar version) and custom bumps
+func (c *CalVer) Supports(t BumpType) bool {
+	return t == BumpPatch || t == BumpCustom
+}
+
+// Recommend always recommends the next calendar version
+func (c *CalVer) Recommend(BumpType) BumpType {
+	return BumpPatch
+}
+
+// dateSegment returns the value of a date token for t
+func dateSegment(token string, t time.Time) uint64 {
+	switch token {
+	case "YYYY":
+		return uint64(t.Year())
+	case "YY", "0Y":
+		return uint64(t.Year() - 2000)
+	case "MM", "0M":
+		return uint64(t.Month())
+	case "WW", "0W":
+		_, week := t.ISOWeek()
+		return uint64(week)
+	case "DD", "0D":
+		return uint64(t.Day())
+	default:
+		return 0
+	}
+}
+
+// parseSegment parses one version segment, checking its padding and range
+func parseSegment(token, part string) (uint64, error) {
+	if part == "" || !isNumeric(part) {
+		return 0, fmt.Errorf("segment %q is not a number", part)
+	}
+
+	padded := strings.HasPrefix(token, "0")
+	switch {
+	case padded && len(part) != 2:
+		return 0, fmt.Errorf("segment %q must be two digits for %s", part, token)
+	case !padded && len(part) > 1 && part[0] == '0':
+		return 0, fmt.Errorf("segment %q has a leading zero for %s", part, token)
+	case token == "YYYY" && len(part) != 4:
+		return 0, fmt.Errorf("segment %q must be a four-digit year", part)
+	}
+
+	n, err := strconv.ParseUint(part, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("segment %q: %w", part, err)
+	}
+
+	limits := map[string]uint64{"MM": 12, "0M": 12, "WW": 53, "0W": 53, "DD": 31, "0D": 31}
+	if limit, ok := limits[token]; ok && (n < 1 || n > limit) {
+		return 0, fmt.Errorf("segment %q is out of range for %s", part, token)
+	}
+
+	return n, nil
+}
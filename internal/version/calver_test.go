@@ -0,0 +1,115 @@
+package version
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fixedNow(year int, month time.Month, day int) func() time.Time {
+	return func() time.Time {
+		return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+	}
+}
+
+func TestNewCalVer_InvalidLayouts(t *testing.T) {
+	for _, layout := range []string{"YYYY.MICRO.MM", "MICRO", "YYYY.QQ", "YYYY.MM.DD.MICRO"} {
+		t.Run(layout, func(t *testing.T) {
+			_, err := NewCalVer(layout)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCalVer_ParseFormat(t *testing.T) {
+	tests := []struct {
+		layout   string
+		input    string
+		expected Version
+	}{
+		{"YYYY.MM.MICRO", "2026.10.3", Version{Major: 2026, Minor: 10, Patch: 3}},
+		{"YY.0M.MICRO", "v26.01.0", Version{Major: 26, Minor: 1, Patch: 0}},
+		{"YYYY.0M.0D", "2026.03.09", Version{Major: 2026, Minor: 3, Patch: 9}},
+		{"YY.0W", "26.07", Version{Major: 26, Minor: 7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			scheme, err := NewCalVer(tt.layout)
+			require.NoError(t, err)
+
+			v, err := scheme.Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, v)
+			assert.Equal(t, tt.input[len(tt.input)-len(scheme.Format(v)):], scheme.Format(v))
+		})
+	}
+}
+
+func TestCalVer_ParseInvalid(t *testing.T) {
+	scheme, err := NewCalVer("YY.0M.MICRO")
+	require.NoError(t, err)
+
+	for _, input := range []string{"26.1.0", "26.13.0", "26.01", "1.2.3-rc.1", "26.01.01"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := scheme.Parse(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCalVer_Bump(t *testing.T) {
+	scheme, err := NewCalVer("YYYY.0M.MICRO")
+	require.NoError(t, err)
+	scheme.Now = fixedNow(2026, time.October, 18)
+
+	// New month restarts MICRO
+	v, err := scheme.Bump(Version{Major: 2026, Minor: 9, Patch: 4}, BumpPatch)
+	require.NoError(t, err)
+	assert.Equal(t, "2026.10.0", scheme.Format(v))
+
+	// Same month increments MICRO
+	v, err = scheme.Bump(v, BumpPatch)
+	require.NoError(t, err)
+	assert.Equal(t, "2026.10.1", scheme.Format(v))
+
+	_, err = scheme.Bump(v, BumpMinor)
+	assert.ErrorIs(t, err, ErrUnsupportedBump)
+}
+
+func TestCalVer_BumpSameDayWithoutMicro(t *testing.T) {
+	scheme, err := NewCalVer("YYYY.0M.0D")
+	require.NoError(t, err)
+	scheme.Now = fixedNow(2026, time.October, 18)
+
+	_, err = scheme.Bump(Version{Major: 2026, Minor: 10, Patch: 18}, BumpPatch)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "add MICRO")
+}
+
+func TestCalVer_Ordering(t *testing.T) {
+	scheme, err := NewCalVer("YY.0M.MICRO")
+	require.NoError(t, err)
+
+	older, err := scheme.Parse("26.09.12")
+	require.NoError(t, err)
+	newer, err := scheme.Parse("26.10.0")
+	require.NoError(t, err)
+
+	assert.True(t, older.LessThan(newer))
+}
+
+func TestNewScheme(t *testing.T) {
+	s, err := NewScheme("", "")
+	require.NoError(t, err)
+	assert.Equal(t, "semver", s.Name())
+
+	s, err = NewScheme("calver", "")
+	require.NoError(t, err)
+	assert.Equal(t, "calver", s.Name())
+
+	_, err = NewScheme("romver", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,126 @@
+package version
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrChannelRegression is returned when a prerelease bump would move to a
+// channel that comes before the current one (e.g., rc -> alpha)
+var ErrChannelRegression = errors.New("prerelease channel regression")
+
+// ErrUnknownChannel is returned when a channel is not in the configured list
+var ErrUnknownChannel = errors.New("unknown prerelease channel")
+
+// channelPattern matches a valid semver prerelease identifier that is not purely numeric
+var channelPattern = regexp.MustCompile(`^[0-9A-Za-z-]*[A-Za-z-][0-9A-Za-z-]*$`)
+
+// Channels is an ordered list of prerelease channel identifiers,
+// from least stable to most stable (e.g., alpha, beta, rc)
+type Channels []string
+
+// DefaultChannels returns the built-in prerelease channels: alpha, beta, rc
+func DefaultChannels() Channels {
+	return Channels{"alpha", "beta", "rc"}
+}
+
+// Index returns the position of the channel in the list, or -1 if not present
+func (c Channels) Index(channel string) int {
+	for i, name := range c {
+		if name == channel {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains returns true if the channel is in the list
+func (c Channels) Contains(channel string) bool {
+	return c.Index(channel) >= 0
+}
+
+// Next returns the channel following the given one.
+// Returns false if the channel is the last one or is not in the list.
+func (c Channels) Next(channel string) (string, bool) {
+	i := c.Index(channel)
+	if i < 0 || i+1 >= len(c) {
+		return "", false
+	}
+	return c[i+1], true
+}
+
+// Compare returns -1, 0 or 1 if a sorts before, equal to or after b, like
+// Compare, except that prereleases of the same version on two listed channels
+// are ordered by the channels' positions rather than alphabetically
+func (c Channels) Compare(a, b Version) int {
+	sameCore := a.Major == b.Major && a.Minor == b.Minor && a.Patch == b.Patch
+	if sameCore && a.IsPrerelease() && b.IsPrerelease() {
+		i, j := c.Index(a.PrereleaseType()), c.Index(b.PrereleaseType())
+		if i >= 0 && j >= 0 && i != j {
+			return cmp.Compare(i, j)
+		}
+	}
+	return Compare(a, b)
+}
+
+// String returns the channels as a comma-separated list
+func (c Channels) String() string {
+	return strings.Join(c, ", ")
+}
+
+// Validate checks that the list is non-empty and that every channel is a
+// unique, non-numeric semver prerelease identifier
+func (c Channels) Validate() error {
+	if len(c) == 0 {
+		return fmt.Errorf("no prerelease channels configured")
+	}
+
+	seen := make(map[string]bool, len(c))
+	for _, name := range c {
+		if !channelPattern.MatchString(name) {
+			return fmt.Errorf("invalid prerelease channel %q", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate prerelease channel %q", name)
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
+
+// Check returns ErrUnknownChannel if the channel is not in the list
+func (c Channels) Check(channel string) error {
+	if !c.Contains(channel) {
+		return fmt.Errorf("%w %q (configured: %s)", ErrUnknownChannel, channel, c)
+	}
+	return nil
+}
+
+// CheckChannelBump verifies that bumping v to the given channel is allowed.
+// The channel must be in the list, and must not come before the channel of
+// the current prerelease. Moving from a stable version, or from a prerelease
+// whose channel is not in the list, is always allowed.
+func (c Channels) CheckChannelBump(v Version, channel string) error {
+	if err := c.Check(channel); err != nil {
+		return err
+	}
+	target := c.Index(channel)
+
+	if !v.IsPrerelease() {
+		return nil
+	}
+
+	current := c.Index(v.PrereleaseType())
+	if current > target {
+		return fmt.Errorf(
+			"%w: cannot move from %s to %s",
+			ErrChannelRegression, v.PrereleaseType(), channel,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannels_Next(t *testing.T) {
+	channels := Channels{"dev", "canary", "next", "preview"}
+
+	next, ok := channels.Next("dev")
+	require.True(t, ok)
+	assert.Equal(t, "canary", next)
+
+	_, ok = channels.Next("preview")
+	assert.False(t, ok, "last channel has no successor")
+
+	_, ok = channels.Next("alpha")
+	assert.False(t, ok, "unknown channel has no successor")
+}
+
+func TestChannels_Compare(t *testing.T) {
+	channels := Channels{"dev", "canary", "next", "preview"}
+	v := func(s string) Version {
+		t.Helper()
+		parsed, err := Parse(s)
+		require.NoError(t, err)
+		return parsed
+	}
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0.1-dev.0", "1.0.1-canary.0", -1},
+		{"1.0.1-preview.0", "1.0.1-next.3", 1},
+		{"1.0.1-canary.1", "1.0.1-canary.0", 1},
+		{"1.0.1-preview.0", "1.0.1", -1},
+		{"1.0.1-preview.0", "1.0.2-dev.0", -1},
+		// Unlisted channels fall back to semver precedence
+		{"1.0.1-alpha.0", "1.0.1-dev.0", -1},
+		{"1.0.1-dev.0", "1.0.1-dev.0", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.want, channels.Compare(v(tt.a), v(tt.b)))
+			assert.Equal(t, -tt.want, channels.Compare(v(tt.b), v(tt.a)))
+		})
+	}
+}
+
+func TestChannels_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		channels    Channels
+		expectError bool
+	}{
+		{"defaults", DefaultChannels(), false},
+		{"custom", Channels{"dev", "canary", "next", "preview"}, false},
+		{"hyphenated", Channels{"pre-alpha", "rc"}, false},
+		{"empty", Channels{}, true},
+		{"numeric", Channels{"alpha", "1"}, true},
+		{"dot", Channels{"alpha.x"}, true},
+		{"blank", Channels{""}, true},
+		{"duplicate", Channels{"beta", "beta"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.channels.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestChannels_CheckChannelBump(t *testing.T) {
+	channels := Channels{"dev", "canary", "next", "preview"}
+
+	tests := []struct {
+		name    string
+		current Version
+		channel string
+		wantErr error
+	}{
+		{"stable to first", Version{Major: 1}, "dev", nil},
+		{"stable to later", Version{Major: 1}, "next", nil},
+		{"same channel", Version{Major: 1, Prerelease: "canary.2"}, "canary", nil},
+		{"forward", Version{Major: 1, Prerelease: "dev.0"}, "preview", nil},
+		{"backward", Version{Major: 1, Prerelease: "next.1"}, "dev", ErrChannelRegression},
+		{"from unlisted", Version{Major: 1, Prerelease: "alpha.0"}, "dev", nil},
+		{"unknown target", Version{Major: 1}, "alpha", ErrUnknownChannel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := channels.CheckChannelBump(tt.current, tt.channel)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package version
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// Constraint is a semver range such as ^1.4, ~2.1, 2.x or >=1.2, <2.0
+type Constraint struct {
+	constraints *semver.Constraints
+}
+
+// ParseConstraint parses a semver range. By default prereleases only satisfy
+// a range that names a prerelease itself (>=2.0.0-0); includePrereleases lets
+// 2.1.0-rc.1 satisfy 2.x as well.
+func ParseConstraint(s string, includePrereleases bool) (*Constraint, error) {
+	c, err := semver.NewConstraint(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid constraint %q: %w", s, err)
+	}
+	c.IncludePrerelease = includePrereleases
+	return &Constraint{constraints: c}, nil
+}
+
+// Check reports whether v satisfies the constraint
+func (c *Constraint) Check(v Version) bool {
+	return c.constraints.Check(v.toSemver())
+}
+
+// String returns the constraint as parsed
+func (c *Constraint) String() string {
+	return c.constraints.String()
+}
+
+// Compare returns -1, 0 or 1 if a sorts before, equal to or after b by semver
+// precedence, for use with slices.SortFunc
+func Compare(a, b Version) int {
+	return a.toSemver().Compare(b.toSemver())
+}
@@ -0,0 +1,61 @@
+package version
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		constraint  string
+		prereleases bool
+		version     string
+		expected    bool
+	}{
+		{"^1.4", false, "1.4.0", true},
+		{"^1.4", false, "1.9.2", true},
+		{"^1.4", false, "2.0.0", false},
+		{"^1.4", false, "1.3.9", false},
+		{"^1.4", false, "1.5.0-rc.1", false},
+		{"^1.4", true, "1.5.0-rc.1", true},
+		{"2.x", true, "2.1.0-beta.2", true},
+		{">=2.0.0-0", false, "2.0.0-alpha.0", true},
+		{"~1.2.0", false, "1.2.7+abc1234", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			c, err := ParseConstraint(tt.constraint, tt.prereleases)
+			require.NoError(t, err)
+
+			v, err := Parse(tt.version)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, c.Check(v))
+		})
+	}
+}
+
+func TestParseConstraint_Invalid(t *testing.T) {
+	_, err := ParseConstraint("^^1", false)
+	assert.Error(t, err)
+}
+
+func TestCompare(t *testing.T) {
+	var versions []Version
+	for _, s := range []string{"1.10.0", "1.2.0", "1.2.0-rc.1", "0.9.0", "1.2.0-alpha.0"} {
+		v, err := Parse(s)
+		require.NoError(t, err)
+		versions = append(versions, v)
+	}
+
+	slices.SortFunc(versions, Compare)
+
+	sorted := make([]string, len(versions))
+	for i, v := range versions {
+		sorted[i] = v.String()
+	}
+	assert.Equal(t, []string{"0.9.0", "1.2.0-alpha.0", "1.2.0-rc.1", "1.2.0", "1.10.0"}, sorted)
+}
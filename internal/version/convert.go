@@ -0,0 +1,129 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// localSeparators matches the characters PEP 440 reads as local version separators
+var localSeparators = regexp.MustCompile(`[-_.]+`)
+
+// segment is a prerelease label with its optional number, like rc and 1 in rc.1
+type segment struct {
+	label  string
+	number string
+}
+
+// segments pairs each prerelease label with the number that follows it.
+// A number without a label gets an empty label.
+func segments(prerelease string) []segment {
+	if prerelease == "" {
+		return nil
+	}
+
+	var segs []segment
+	for _, id := range strings.Split(prerelease, ".") {
+		last := len(segs) - 1
+		if isNumeric(id) && last >= 0 && segs[last].number == "" {
+			segs[last].number = id
+			continue
+		}
+		if isNumeric(id) {
+			segs = append(segs, segment{number: id})
+			continue
+		}
+		segs = append(segs, segment{label: strings.ToLower(id)})
+	}
+	return segs
+}
+
+// PEP440 converts a version to a normalized Python (PEP 440) version:
+// 1.2.0-rc.1 becomes 1.2.0rc1, 1.2.0-beta.2 becomes 1.2.0b2 and
+// 1.2.0-rc.1.dev.5 becomes 1.2.0rc1.dev5. Channels PEP 440 has no name for
+// (e.g., canary.3) become a dev release with the channel as local label
+// (1.2.0.dev3+canary), and build metadata becomes the local version.
+func PEP440(v Version) string {
+	var pre, post, dev string
+	var local []string
+
+	for _, seg := range segments(v.Prerelease) {
+		number := seg.number
+		if number == "" {
+			number = "0"
+		}
+
+		switch seg.label {
+		case "alpha", "a":
+			if pre == "" {
+				pre = "a" + number
+				continue
+			}
+		case "beta", "b":
+			if pre == "" {
+				pre = "b" + number
+				continue
+			}
+		case "rc", "c", "pre", "preview":
+			if pre == "" {
+				pre = "rc" + number
+				continue
+			}
+		case "post", "rev", "r":
+			if post == "" {
+				post = ".post" + number
+				continue
+			}
+		case "dev", "":
+			if dev == "" {
+				dev = ".dev" + number
+				continue
+			}
+		default:
+			if dev == "" {
+				dev = ".dev" + number
+				local = append(local, seg.label)
+				continue
+			}
+		}
+
+		// A second pre, post or dev part has no place in PEP 440
+		local = append(local, seg.label)
+		if seg.number != "" {
+			local = append(local, seg.number)
+		}
+	}
+
+	if v.Metadata != "" {
+		local = append(local, localSeparators.Split(strings.ToLower(v.Metadata), -1)...)
+	}
+
+	s := fmt.Sprintf("%d.%d.%d%s%s%s", v.Major, v.Minor, v.Patch, pre, post, dev)
+	if len(local) > 0 {
+		s += "+" + strings.Join(local, ".")
+	}
+	return s
+}
+
+// Maven converts a version to a Maven version using the qualifiers Maven
+// orders correctly: 1.2.0-rc.1 becomes 1.2.0-RC1, 1.2.0-alpha.2 becomes
+// 1.2.0-alpha2 and a dev part becomes SNAPSHOT (1.2.0-rc.1.dev.5 becomes
+// 1.2.0-RC1-SNAPSHOT). Build metadata has no Maven equivalent and is dropped.
+func Maven(v Version) string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+
+	for _, seg := range segments(v.Prerelease) {
+		var qualifier string
+		switch seg.label {
+		case "rc", "c", "cr":
+			qualifier = "RC" + seg.number
+		case "dev", "snapshot":
+			qualifier = "SNAPSHOT"
+		default:
+			qualifier = seg.label + seg.number
+		}
+		s += "-" + qualifier
+	}
+
+	return s
+}
@@ -0,0 +1,75 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPEP440(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1.2.0", "1.2.0"},
+		{"1.2.0-rc.1", "1.2.0rc1"},
+		{"1.2.0-alpha.0", "1.2.0a0"},
+		{"1.2.0-beta.2", "1.2.0b2"},
+		{"1.2.0-rc", "1.2.0rc0"},
+		{"1.5.0-rc.1.dev.5", "1.5.0rc1.dev5"},
+		{"1.4.3-dev.5+abc1234", "1.4.3.dev5+abc1234"},
+		{"1.2.0-canary.3", "1.2.0.dev3+canary"},
+		{"1.2.0-post.1", "1.2.0.post1"},
+		{"1.2.0+Build-7.x", "1.2.0+build.7.x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			v, err := Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, PEP440(v))
+		})
+	}
+}
+
+func TestMaven(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1.2.0", "1.2.0"},
+		{"1.2.0-rc.1", "1.2.0-RC1"},
+		{"1.2.0-alpha.2", "1.2.0-alpha2"},
+		{"1.2.0-beta.0", "1.2.0-beta0"},
+		{"1.5.0-rc.1.dev.5", "1.5.0-RC1-SNAPSHOT"},
+		{"1.4.3-dev.5+abc1234", "1.4.3-SNAPSHOT"},
+		{"1.2.0-canary.3", "1.2.0-canary3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			v, err := Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, Maven(v))
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	data := struct{ Version string }{Version: "1.2.0-rc.1"}
+
+	out, err := Render(`py={{ pep440 .Version }} mvn={{ .Version | maven }}`, data)
+	require.NoError(t, err)
+	assert.Equal(t, "py=1.2.0rc1 mvn=1.2.0-RC1", out)
+
+	out, err = Render("echo plain", data)
+	require.NoError(t, err)
+	assert.Equal(t, "echo plain", out)
+
+	_, err = Render("{{ pep440 .Missing }}", data)
+	assert.Error(t, err)
+
+	_, err = Render("{{ pep440 \"not-a-version\" }}", data)
+	assert.Error(t, err)
+}
@@ -0,0 +1,36 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IsFloating reports whether s is a major or major.minor version such as v1
+// or v1.4, which Parse would complete to v1.0.0 and v1.4.0. Such tags float
+// along with the latest release of their line rather than marking a release.
+func IsFloating(s string) bool {
+	s = strings.TrimPrefix(s, "v")
+	if s == "" || strings.ContainsAny(s, "-+") {
+		return false
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 2 {
+		return false
+	}
+	for _, part := range parts {
+		if !isNumeric(part) {
+			return false
+		}
+	}
+	return true
+}
+
+// FloatingVersions returns the major and major.minor versions that float
+// along with v, e.g. 1 and 1.4 for 1.4.2
+func FloatingVersions(v Version) []string {
+	return []string{
+		fmt.Sprintf("%d", v.Major),
+		fmt.Sprintf("%d.%d", v.Major, v.Minor),
+	}
+}
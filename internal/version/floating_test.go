@@ -0,0 +1,16 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsFloating(t *testing.T) {
+	assert.True(t, IsFloating("v1"))
+	assert.True(t, IsFloating("1.4"))
+	assert.False(t, IsFloating("v1.4.0"))
+	assert.False(t, IsFloating("v1-rc"))
+	assert.False(t, IsFloating("latest"))
+	assert.False(t, IsFloating(""))
+}
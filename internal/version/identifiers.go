@@ -0,0 +1,120 @@
+package version
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidPrerelease is returned when a prerelease is not valid semver
+var ErrInvalidPrerelease = errors.New("invalid prerelease")
+
+// ErrNoPrereleaseNumber is returned when a prerelease has no numeric identifier to bump
+var ErrNoPrereleaseNumber = errors.New("prerelease has no numeric identifier")
+
+// ErrLegacyPrerelease is returned when a prerelease uses a non-semver
+// numbering form such as rc1 or beta-2
+var ErrLegacyPrerelease = errors.New("legacy prerelease format")
+
+// identifierPattern matches the characters allowed in a semver prerelease identifier
+var identifierPattern = regexp.MustCompile(`^[0-9A-Za-z-]+$`)
+
+// legacyPattern matches a channel with a number attached, like rc1, beta-2 or alpha_03
+var legacyPattern = regexp.MustCompile(`^([A-Za-z]+)[-_]?([0-9]+)$`)
+
+// Prerelease is a semver prerelease split into its dot-separated identifiers
+type Prerelease []string
+
+// SplitPrerelease splits a prerelease string into identifiers, validating
+// each one against the semver rules
+func SplitPrerelease(s string) (Prerelease, error) {
+	if s == "" {
+		return nil, fmt.Errorf("%w: empty prerelease string", ErrInvalidPrerelease)
+	}
+
+	ids := strings.Split(s, ".")
+	for _, id := range ids {
+		if !identifierPattern.MatchString(id) {
+			return nil, fmt.Errorf("%w: bad identifier %q in %q", ErrInvalidPrerelease, id, s)
+		}
+		if isNumeric(id) && len(id) > 1 && id[0] == '0' {
+			return nil, fmt.Errorf("%w: leading zero in %q", ErrInvalidPrerelease, s)
+		}
+	}
+
+	return Prerelease(ids), nil
+}
+
+// String joins the identifiers back into a prerelease string
+func (p Prerelease) String() string {
+	return strings.Join(p, ".")
+}
+
+// Channel returns the first identifier when it is alphanumeric (e.g., "alpha"
+// in alpha.1.hotfix.2), or an empty string when it is numeric
+func (p Prerelease) Channel() string {
+	if len(p) == 0 || isNumeric(p[0]) {
+		return ""
+	}
+	return p[0]
+}
+
+// Increment returns a copy with the last numeric identifier incremented
+func (p Prerelease) Increment() (Prerelease, error) {
+	for i := len(p) - 1; i >= 0; i-- {
+		if !isNumeric(p[i]) {
+			continue
+		}
+
+		n, err := strconv.ParseUint(p[i], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPrerelease, err)
+		}
+
+		next := make(Prerelease, len(p))
+		copy(next, p)
+		next[i] = strconv.FormatUint(n+1, 10)
+		return next, nil
+	}
+
+	return nil, ErrNoPrereleaseNumber
+}
+
+// NormalizePrerelease rewrites legacy prerelease forms into semver
+// identifiers: a number attached to the channel is split off (rc1 -> rc.1,
+// beta-2 -> beta.2), and a bare channel gets a counter (alpha -> alpha.0).
+// Prereleases already in the channel.N form are returned unchanged.
+func NormalizePrerelease(s string) string {
+	if s == "" {
+		return s
+	}
+
+	ids := strings.Split(s, ".")
+	if m := legacyPattern.FindStringSubmatch(ids[0]); m != nil {
+		n, err := strconv.ParseUint(m[2], 10, 64)
+		if err == nil {
+			ids = append([]string{m[1], strconv.FormatUint(n, 10)}, ids[1:]...)
+		}
+	}
+
+	if _, err := Prerelease(ids).Increment(); errors.Is(err, ErrNoPrereleaseNumber) {
+		ids = append(ids, "0")
+	}
+
+	return strings.Join(ids, ".")
+}
+
+// isNumeric returns true if s is a non-empty string of ASCII digits
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
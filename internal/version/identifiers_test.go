@@ -0,0 +1,120 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitPrerelease(t *testing.T) {
+	pre, err := SplitPrerelease("alpha.1.hotfix.2")
+	require.NoError(t, err)
+	assert.Equal(t, Prerelease{"alpha", "1", "hotfix", "2"}, pre)
+	assert.Equal(t, "alpha", pre.Channel())
+	assert.Equal(t, "alpha.1.hotfix.2", pre.String())
+
+	numeric, err := SplitPrerelease("1.2")
+	require.NoError(t, err)
+	assert.Empty(t, numeric.Channel())
+
+	for _, input := range []string{"", "alpha..1", "alpha.01", "beta_1", "rc.1+meta"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := SplitPrerelease(input)
+			assert.ErrorIs(t, err, ErrInvalidPrerelease)
+		})
+	}
+}
+
+func TestPrerelease_Increment(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"alpha.0", "alpha.1"},
+		{"rc.9", "rc.10"},
+		{"alpha.1.hotfix.2", "alpha.1.hotfix.3"},
+		{"beta.3.exp", "beta.4.exp"},
+		{"0", "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			pre, err := SplitPrerelease(tt.input)
+			require.NoError(t, err)
+
+			next, err := pre.Increment()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, next.String())
+			assert.Equal(t, tt.input, pre.String(), "original is not modified")
+		})
+	}
+
+	_, err := Prerelease{"alpha", "hotfix"}.Increment()
+	assert.ErrorIs(t, err, ErrNoPrereleaseNumber)
+}
+
+func TestNormalizePrerelease(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"rc1", "rc.1"},
+		{"beta-2", "beta.2"},
+		{"alpha_03", "alpha.3"},
+		{"alpha", "alpha.0"},
+		{"rc1.hotfix", "rc.1.hotfix"},
+		{"alpha.1", "alpha.1"},
+		{"alpha.1.hotfix.2", "alpha.1.hotfix.2"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizePrerelease(tt.input))
+		})
+	}
+}
+
+func TestNextPrerelease(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		channel  string
+		compat   bool
+		expected string
+		wantErr  error
+	}{
+		{"stable", "1.2.3", "beta", false, "1.2.4-beta.0", nil},
+		{"same channel", "1.3.0-beta.2", "beta", false, "1.3.0-beta.3", nil},
+		{"multi-part", "1.3.0-alpha.1.hotfix.2", "alpha", false, "1.3.0-alpha.1.hotfix.3", nil},
+		{"channel change", "1.3.0-alpha.1.hotfix.2", "beta", false, "1.3.0-beta.0", nil},
+		{"legacy strict", "1.3.0-rc1", "rc", false, "", ErrLegacyPrerelease},
+		{"legacy compat", "1.3.0-rc1", "rc", true, "1.3.0-rc.2", nil},
+		{"legacy hyphen compat", "1.3.0-beta-2", "beta", true, "1.3.0-beta.3", nil},
+		{"bare channel strict", "1.3.0-alpha", "alpha", false, "", ErrNoPrereleaseNumber},
+		{"bare channel compat", "1.3.0-alpha", "alpha", true, "1.3.0-alpha.1", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Parse(tt.input)
+			require.NoError(t, err)
+
+			result, err := NextPrerelease(v, tt.channel, tt.compat)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, v, result, "version is left alone on error")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.String())
+		})
+	}
+}
+
+func TestBumpPrerelease_ReportsLegacyShape(t *testing.T) {
+	v := Version{Major: 1, Minor: 2, Patch: 0, Prerelease: "rc1"}
+	_, err := BumpPrerelease(v, "rc")
+	require.ErrorIs(t, err, ErrLegacyPrerelease)
+}
@@ -0,0 +1,50 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MetadataPolicy controls where the build metadata of a release is recorded
+type MetadataPolicy string
+
+const (
+	// MetadataInAnnotation keeps the tag name free of metadata; the tag
+	// annotation and outputs carry it (v1.2.3, annotated with the metadata)
+	MetadataInAnnotation MetadataPolicy = "annotation"
+	// MetadataInTag puts the metadata into the tag name (v1.2.3+abc1234)
+	MetadataInTag MetadataPolicy = "tag"
+)
+
+// ParseMetadataPolicy parses a metadata policy; empty means MetadataInAnnotation
+func ParseMetadataPolicy(s string) (MetadataPolicy, error) {
+	switch MetadataPolicy(s) {
+	case "", MetadataInAnnotation:
+		return MetadataInAnnotation, nil
+	case MetadataInTag:
+		return MetadataInTag, nil
+	default:
+		return "", fmt.Errorf("unknown metadata policy %q (use annotation or tag)", s)
+	}
+}
+
+// ValidateMetadata checks build metadata against the semver rules: non-empty
+// dot-separated identifiers of ASCII letters, digits and hyphens
+func ValidateMetadata(s string) error {
+	if s == "" {
+		return fmt.Errorf("empty build metadata")
+	}
+	for _, id := range strings.Split(s, ".") {
+		if !identifierPattern.MatchString(id) {
+			return fmt.Errorf("invalid build metadata %q: bad identifier %q", s, id)
+		}
+	}
+	return nil
+}
+
+// WithMetadata returns a copy of v with the given build metadata.
+// Bumps always drop metadata, since it describes a single build.
+func (v Version) WithMetadata(metadata string) Version {
+	v.Metadata = metadata
+	return v
+}
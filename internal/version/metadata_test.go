@@ -0,0 +1,29 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMetadata(t *testing.T) {
+	assert.NoError(t, ValidateMetadata("abc1234.20261018"))
+	assert.NoError(t, ValidateMetadata("build-7.001"))
+	assert.Error(t, ValidateMetadata(""))
+	assert.Error(t, ValidateMetadata("abc..def"))
+	assert.Error(t, ValidateMetadata("feat/login"))
+}
+
+func TestParseMetadataPolicy(t *testing.T) {
+	policy, err := ParseMetadataPolicy("")
+	require.NoError(t, err)
+	assert.Equal(t, MetadataInAnnotation, policy)
+
+	policy, err = ParseMetadataPolicy("tag")
+	require.NoError(t, err)
+	assert.Equal(t, MetadataInTag, policy)
+
+	_, err = ParseMetadataPolicy("name")
+	assert.Error(t, err)
+}
@@ -2,39 +2,26 @@ package version
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 )
 
-// ParsePrerelease parses a prerelease string (e.g., "alpha.0") into type and number
-func ParsePrerelease(prerelease string) (string, int, error) {
-	if prerelease == "" {
-		return "", 0, fmt.Errorf("empty prerelease string")
-	}
-
-	parts := strings.SplitN(prerelease, ".", 2)
-	if len(parts) != 2 {
-		return "", 0, fmt.Errorf("invalid prerelease format: %q", prerelease)
-	}
-
-	preType := parts[0]
-	if preType == "" {
-		return "", 0, fmt.Errorf("empty prerelease type")
-	}
-
-	num, err := strconv.Atoi(parts[1])
-	if err != nil {
-		return "", 0, fmt.Errorf("invalid prerelease number: %w", err)
-	}
-
-	return preType, num, nil
-}
-
 // BumpPrerelease bumps to the specified prerelease type
-// If already at that type, increments the number
+// If already at that type, increments the last numeric identifier
 // If changing type (e.g., alpha -> beta), starts at 0
 // If not a prerelease, bumps patch and adds prerelease
-func BumpPrerelease(v Version, preType string) Version {
+// Existing prereleases that cannot be bumped are reported as errors
+// (see NextPrerelease).
+func BumpPrerelease(v Version, preType string) (Version, error) {
+	return NextPrerelease(v, preType, false)
+}
+
+// NextPrerelease bumps v to the given prerelease channel, keeping every
+// identifier of an existing prerelease on the same channel and incrementing
+// its last numeric identifier (alpha.1.hotfix.2 -> alpha.1.hotfix.3).
+// Prereleases that have no numeric identifier, or that use a legacy form
+// such as rc1 or beta-2, are reported as errors rather than restarted.
+// With compat set, legacy forms are normalized first (see NormalizePrerelease).
+func NextPrerelease(v Version, channel string, compat bool) (Version, error) {
 	result := Version{
 		Major: v.Major,
 		Minor: v.Minor,
@@ -42,32 +29,84 @@ func BumpPrerelease(v Version, preType string) Version {
 	}
 
 	// If no existing prerelease, bump patch and start at 0
-	if v.Prerelease == "" {
+	if !v.IsPrerelease() {
 		result.Patch++
-		result.Prerelease = fmt.Sprintf("%s.0", preType)
-		return result
+		result.Prerelease = fmt.Sprintf("%s.0", channel)
+		return result, nil
 	}
 
-	// Parse existing prerelease
-	existingType, existingNum, err := ParsePrerelease(v.Prerelease)
+	raw := v.Prerelease
+	if compat {
+		raw = NormalizePrerelease(raw)
+	}
+
+	pre, err := SplitPrerelease(raw)
 	if err != nil {
-		// Invalid existing prerelease, treat as new
-		result.Patch++
-		result.Prerelease = fmt.Sprintf("%s.0", preType)
-		return result
+		return v, err
 	}
 
-	// Same type: increment number
-	if existingType == preType {
-		result.Prerelease = fmt.Sprintf("%s.%d", preType, existingNum+1)
-		return result
+	if pre.Channel() != channel {
+		normalized, _ := SplitPrerelease(NormalizePrerelease(raw))
+		if normalized.Channel() == channel {
+			return v, fmt.Errorf(
+				"%w: %q (enable prerelease compat to read it as %q)",
+				ErrLegacyPrerelease, v.Prerelease, normalized,
+			)
+		}
+
+		// Different type: start at 0
+		result.Prerelease = fmt.Sprintf("%s.0", channel)
+		return result, nil
 	}
 
-	// Different type: start at 0
-	result.Prerelease = fmt.Sprintf("%s.0", preType)
+	// Same type: increment the last number in place
+	next, err := pre.Increment()
+	if err != nil {
+		return v, fmt.Errorf("cannot bump prerelease %q: %w", v.Prerelease, err)
+	}
+	result.Prerelease = next.String()
+	return result, nil
+}
+
+// BumpPreLevel bumps the major, minor or patch number according to a
+// pre-level bump type and starts a new prerelease on the given channel.
+// Unlike BumpPrerelease, it always moves to a new version line, even when
+// the current version is already a prerelease.
+func BumpPreLevel(v Version, bumpType BumpType, channel string) Version {
+	result := bumpLevel(v, bumpType.Base())
+	result.Prerelease = fmt.Sprintf("%s.0", channel)
 	return result
 }
 
+// PrereleaseBumpFor returns the bump needed to put a change of the given
+// release level (BumpMajor, BumpMinor or BumpPatch) onto a prerelease.
+// If the current prerelease already targets a version at that level, the
+// prerelease continues on its line (BumpPrereleaseChannel); otherwise a
+// pre-level bump starts a new line.
+func PrereleaseBumpFor(current Version, level BumpType) BumpType {
+	if current.IsPrerelease() {
+		switch level {
+		case BumpMajor:
+			if current.Minor == 0 && current.Patch == 0 {
+				return BumpPrereleaseChannel
+			}
+		case BumpMinor:
+			if current.Patch == 0 {
+				return BumpPrereleaseChannel
+			}
+		case BumpPatch:
+			return BumpPrereleaseChannel
+		default:
+			// Other bump types start a new line below
+		}
+	}
+
+	if pre := level.PreLevel(); pre != 0 {
+		return pre
+	}
+	return BumpPrepatch
+}
+
 // BumpToRelease strips the prerelease identifier, making it a release version
 func BumpToRelease(v Version) Version {
 	return Version{
@@ -7,54 +7,11 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-// T104: Test for parsing prerelease version
-func TestParsePrerelease(t *testing.T) {
-	tests := []struct {
-		name       string
-		input      string
-		wantType   string
-		wantNumber int
-	}{
-		{"alpha.0", "alpha.0", "alpha", 0},
-		{"alpha.1", "alpha.1", "alpha", 1},
-		{"beta.0", "beta.0", "beta", 0},
-		{"beta.5", "beta.5", "beta", 5},
-		{"rc.0", "rc.0", "rc", 0},
-		{"rc.10", "rc.10", "rc", 10},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			preType, preNum, err := ParsePrerelease(tt.input)
-			require.NoError(t, err)
-			assert.Equal(t, tt.wantType, preType)
-			assert.Equal(t, tt.wantNumber, preNum)
-		})
-	}
-}
-
-// T105: Test for extracting prerelease type and number
-func TestParsePrerelease_Invalid(t *testing.T) {
-	tests := []string{
-		"invalid",
-		"alpha",
-		"beta.",
-		".0",
-		"",
-	}
-
-	for _, input := range tests {
-		t.Run(input, func(t *testing.T) {
-			_, _, err := ParsePrerelease(input)
-			assert.Error(t, err)
-		})
-	}
-}
-
 // T107: Test v1.0.0 → v1.0.1-alpha.0
 func TestBumpPrerelease_NewAlpha(t *testing.T) {
 	v := Version{Major: 1, Minor: 0, Patch: 0}
-	result := BumpPrerelease(v, "alpha")
+	result, err := BumpPrerelease(v, "alpha")
+	require.NoError(t, err)
 
 	assert.Equal(t, uint64(1), result.Major)
 	assert.Equal(t, uint64(0), result.Minor)
@@ -65,7 +22,8 @@ func TestBumpPrerelease_NewAlpha(t *testing.T) {
 // T108: Test v1.0.1-alpha.0 → v1.0.1-alpha.1
 func TestBumpPrerelease_IncrementAlpha(t *testing.T) {
 	v := Version{Major: 1, Minor: 0, Patch: 1, Prerelease: "alpha.0"}
-	result := BumpPrerelease(v, "alpha")
+	result, err := BumpPrerelease(v, "alpha")
+	require.NoError(t, err)
 
 	assert.Equal(t, uint64(1), result.Major)
 	assert.Equal(t, uint64(0), result.Minor)
@@ -76,7 +34,8 @@ func TestBumpPrerelease_IncrementAlpha(t *testing.T) {
 // T109: Test v1.0.1-alpha.1 → v1.0.1-beta.0
 func TestBumpPrerelease_AlphaToBeta(t *testing.T) {
 	v := Version{Major: 1, Minor: 0, Patch: 1, Prerelease: "alpha.1"}
-	result := BumpPrerelease(v, "beta")
+	result, err := BumpPrerelease(v, "beta")
+	require.NoError(t, err)
 
 	assert.Equal(t, uint64(1), result.Major)
 	assert.Equal(t, uint64(0), result.Minor)
@@ -97,7 +56,8 @@ func TestBumpRelease(t *testing.T) {
 
 func TestBumpPrerelease_BetaToRC(t *testing.T) {
 	v := Version{Major: 1, Minor: 0, Patch: 1, Prerelease: "beta.2"}
-	result := BumpPrerelease(v, "rc")
+	result, err := BumpPrerelease(v, "rc")
+	require.NoError(t, err)
 
 	assert.Equal(t, uint64(1), result.Major)
 	assert.Equal(t, uint64(0), result.Minor)
@@ -107,14 +67,16 @@ func TestBumpPrerelease_BetaToRC(t *testing.T) {
 
 func TestBumpPrerelease_IncrementRC(t *testing.T) {
 	v := Version{Major: 1, Minor: 0, Patch: 1, Prerelease: "rc.0"}
-	result := BumpPrerelease(v, "rc")
+	result, err := BumpPrerelease(v, "rc")
+	require.NoError(t, err)
 
 	assert.Equal(t, "rc.1", result.Prerelease)
 }
 
 func TestBumpPrerelease_FromZero(t *testing.T) {
 	v := Zero()
-	result := BumpPrerelease(v, "alpha")
+	result, err := BumpPrerelease(v, "alpha")
+	require.NoError(t, err)
 
 	assert.Equal(t, uint64(0), result.Major)
 	assert.Equal(t, uint64(0), result.Minor)
@@ -129,3 +91,82 @@ func TestBumpRelease_AlreadyRelease(t *testing.T) {
 	// No change for already-release version
 	assert.Equal(t, v, result)
 }
+
+func TestBumpPreLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Version
+		bumpType BumpType
+		channel  string
+		expected string
+	}{
+		{"premajor", Version{Major: 1, Minor: 2, Patch: 3}, BumpPremajor, "beta", "2.0.0-beta.0"},
+		{"preminor", Version{Major: 1, Minor: 2, Patch: 3}, BumpPreminor, "alpha", "1.3.0-alpha.0"},
+		{"prepatch", Version{Major: 1, Minor: 2, Patch: 3}, BumpPrepatch, "rc", "1.2.4-rc.0"},
+		{
+			"preminor from prerelease starts new line",
+			Version{Major: 1, Minor: 3, Patch: 0, Prerelease: "beta.2"},
+			BumpPreminor, "beta", "1.4.0-beta.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := BumpPreLevel(tt.input, tt.bumpType, tt.channel)
+			assert.Equal(t, tt.expected, result.String())
+		})
+	}
+}
+
+func TestPrereleaseBumpFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  Version
+		level    BumpType
+		expected BumpType
+	}{
+		{"stable minor", Version{Major: 1, Minor: 2, Patch: 3}, BumpMinor, BumpPreminor},
+		{"stable major", Version{Major: 1, Minor: 2, Patch: 3}, BumpMajor, BumpPremajor},
+		{"stable patch", Version{Major: 1, Minor: 2, Patch: 3}, BumpPatch, BumpPrepatch},
+		{
+			"minor prerelease covers minor",
+			Version{Major: 1, Minor: 3, Prerelease: "beta.0"},
+			BumpMinor, BumpPrereleaseChannel,
+		},
+		{
+			"patch prerelease needs minor",
+			Version{Major: 1, Minor: 2, Patch: 4, Prerelease: "beta.0"},
+			BumpMinor, BumpPreminor,
+		},
+		{
+			"minor prerelease needs major",
+			Version{Major: 1, Minor: 3, Prerelease: "beta.0"},
+			BumpMajor, BumpPremajor,
+		},
+		{
+			"major prerelease covers major",
+			Version{Major: 2, Prerelease: "alpha.1"},
+			BumpMajor, BumpPrereleaseChannel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, PrereleaseBumpFor(tt.current, tt.level))
+		})
+	}
+}
+
+func TestSemver_BumpPrerelease(t *testing.T) {
+	result, err := Semver{}.Bump(Version{Major: 1, Minor: 0, Patch: 1, Prerelease: "beta.2"},
+		BumpPrereleaseBeta)
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.1-beta.3", result.String())
+
+	_, err = Semver{}.Bump(Version{Major: 1, Minor: 0, Patch: 1, Prerelease: "beta"},
+		BumpPrereleaseBeta)
+	assert.Error(t, err)
+
+	_, err = Semver{}.Bump(Version{Major: 1, Minor: 0, Patch: 1}, BumpPrereleaseChannel)
+	assert.ErrorIs(t, err, ErrUnsupportedBump)
+}
@@ -0,0 +1,76 @@
+package version
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnsupportedBump is returned when a scheme has no meaning for a bump type,
+// such as a minor bump under calendar versioning
+var ErrUnsupportedBump = errors.New("bump type not supported by version scheme")
+
+// Scheme parses, formats and bumps versions for a versioning convention.
+// Versions of every scheme are stored in Version and compared field by field.
+type Scheme interface {
+	// Name returns the scheme name used in config (e.g., "semver")
+	Name() string
+	// Parse parses a version string, with or without a "v" prefix
+	Parse(s string) (Version, error)
+	// Format returns the canonical string of v, without prefix
+	Format(v Version) string
+	// Bump returns the next version for the bump type
+	Bump(v Version, t BumpType) (Version, error)
+	// Supports reports whether the bump type is meaningful for the scheme
+	Supports(t BumpType) bool
+	// Recommend maps a conventional commit recommendation onto the scheme
+	Recommend(t BumpType) BumpType
+}
+
+// Semver is the semantic versioning scheme
+type Semver struct{}
+
+// Name returns "semver"
+func (Semver) Name() string { return "semver" }
+
+// Parse parses a semantic version
+func (Semver) Parse(s string) (Version, error) { return Parse(s) }
+
+// Format returns the semantic version string
+func (Semver) Format(v Version) string { return v.String() }
+
+// Bump bumps the version by the bump type
+func (Semver) Bump(v Version, t BumpType) (Version, error) {
+	return Bump(v, t)
+}
+
+// Supports returns true for every bump type
+func (Semver) Supports(BumpType) bool { return true }
+
+// Recommend returns the recommendation unchanged
+func (Semver) Recommend(t BumpType) BumpType { return t }
+
+// DefaultScheme returns the semver scheme
+func DefaultScheme() Scheme {
+	return Semver{}
+}
+
+// NewScheme returns the scheme with the given name. The layout is only used
+// by calver; an empty layout uses DefaultCalVerLayout.
+func NewScheme(name, layout string) (Scheme, error) {
+	switch name {
+	case "", "semver":
+		return Semver{}, nil
+	case "calver":
+		if layout == "" {
+			layout = DefaultCalVerLayout
+		}
+		return NewCalVer(layout)
+	default:
+		return nil, fmt.Errorf("unknown version scheme %q (use semver or calver)", name)
+	}
+}
+
+// FormatWithPrefix returns the version formatted by the scheme, with the prefix
+func FormatWithPrefix(s Scheme, v Version, prefix string) string {
+	return prefix + s.Format(v)
+}
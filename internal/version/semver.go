@@ -64,8 +64,15 @@ func (v Version) LessThan(other Version) bool {
 	return sv1.LessThan(sv2)
 }
 
-// Equal returns true if v equals other
+// Equal returns true if v and other are the same version, including build
+// metadata (1.2.3+a and 1.2.3+b are different versions)
 func (v Version) Equal(other Version) bool {
+	return v.SamePrecedence(other) && v.Metadata == other.Metadata
+}
+
+// SamePrecedence returns true if v and other sort equally, which ignores
+// build metadata (1.2.3+a and 1.2.3+b have the same precedence)
+func (v Version) SamePrecedence(other Version) bool {
 	return v.Major == other.Major &&
 		v.Minor == other.Minor &&
 		v.Patch == other.Patch &&
@@ -227,3 +227,14 @@ func TestVersion_Comparison(t *testing.T) {
 		})
 	}
 }
+
+func TestVersion_EqualMetadata(t *testing.T) {
+	a := Version{Major: 1, Minor: 2, Patch: 3, Metadata: "abc1234"}
+	b := Version{Major: 1, Minor: 2, Patch: 3, Metadata: "def5678"}
+
+	assert.False(t, a.Equal(b))
+	assert.True(t, a.SamePrecedence(b))
+	assert.True(t, a.Equal(a.WithMetadata("abc1234")))
+	assert.False(t, a.LessThan(b))
+	assert.False(t, b.LessThan(a))
+}
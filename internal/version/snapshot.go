@@ -0,0 +1,43 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// invalidIdentifierChars matches runs of characters not allowed in a semver identifier
+var invalidIdentifierChars = regexp.MustCompile(`[^0-9A-Za-z-]+`)
+
+// SanitizeIdentifier turns an arbitrary string such as a branch name into a
+// valid, alphanumeric semver prerelease identifier (feat/Login_v2 -> feat-login-v2).
+// Returns "snapshot" if nothing usable is left.
+func SanitizeIdentifier(s string) string {
+	id := invalidIdentifierChars.ReplaceAllString(strings.ToLower(s), "-")
+	id = strings.Trim(id, "-")
+	for strings.Contains(id, "--") {
+		id = strings.ReplaceAll(id, "--", "-")
+	}
+
+	if id == "" {
+		return "snapshot"
+	}
+	// A purely numeric identifier would be read as a number
+	if isNumeric(id) {
+		return "b" + id
+	}
+	return id
+}
+
+// Snapshot returns a unique version for a commit on a branch, derived from
+// the next version: 1.5.0 on feat/login, 7 commits after the tag, at abc1234
+// gives 1.5.0-feat-login.7+gabc1234
+func Snapshot(next Version, branch string, commits int, shortHash string) Version {
+	return Version{
+		Major:      next.Major,
+		Minor:      next.Minor,
+		Patch:      next.Patch,
+		Prerelease: fmt.Sprintf("%s.%d", SanitizeIdentifier(branch), commits),
+		Metadata:   "g" + shortHash,
+	}
+}
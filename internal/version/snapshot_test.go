@@ -0,0 +1,40 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeIdentifier(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"feat/login", "feat-login"},
+		{"Feature/Login_V2", "feature-login-v2"},
+		{"fix//double..dots", "fix-double-dots"},
+		{"-leading-and-trailing-", "leading-and-trailing"},
+		{"1234", "b1234"},
+		{"///", "snapshot"},
+		{"", "snapshot"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, SanitizeIdentifier(tt.input))
+		})
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	next := Version{Major: 1, Minor: 5, Patch: 0}
+
+	v := Snapshot(next, "feat/login", 7, "abc1234")
+
+	assert.Equal(t, "1.5.0-feat-login.7+gabc1234", v.String())
+
+	parsed, err := Parse(v.String())
+	assert.NoError(t, err)
+	assert.Equal(t, v, parsed)
+}
@@ -0,0 +1,49 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// TemplateFuncs returns the template functions for converting version
+// strings, e.g. {{ pep440 .Version }} or {{ .Version | maven }}, and for
+// joining lists, e.g. {{ join .Contributors ", " }}
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"semver": convertFunc(Version.String),
+		"pep440": convertFunc(PEP440),
+		"maven":  convertFunc(Maven),
+		"join":   strings.Join,
+	}
+}
+
+// convertFunc wraps a converter as a template function taking a version string
+func convertFunc(convert func(Version) string) func(string) (string, error) {
+	return func(s string) (string, error) {
+		v, err := Parse(s)
+		if err != nil {
+			return "", err
+		}
+		return convert(v), nil
+	}
+}
+
+// Render executes a text template with the version template functions.
+// Text without template actions is returned unchanged.
+func Render(text string, data any) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+
+	tmpl, err := template.New("").Funcs(TemplateFuncs()).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid template %q: %w", text, err)
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("failed to render template %q: %w", text, err)
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,103 @@
+// Package versionfile keeps a version string in Go source up to date, so the
+// committed code matches the release tag.
+package versionfile
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+)
+
+// Update sets the string constant or variable name in the Go file at path to
+// value, leaving the rest of the file untouched. If the file does not exist it
+// is created in package pkg. Returns false if the file already had the value.
+func Update(path, name, value, pkg string) (bool, error) {
+	src, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		src = skeleton(pkg, name)
+	} else if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	out, err := Rewrite(src, name, value)
+	if err != nil {
+		return false, fmt.Errorf("failed to update %s: %w", path, err)
+	}
+
+	if bytes.Equal(out, src) {
+		return false, nil
+	}
+
+	//nolint:gosec // Source files need to be readable by the user
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return false, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return true, nil
+}
+
+// Rewrite returns src with the top-level string constant or variable name set
+// to value. Only the literal's bytes are replaced; the rest of src, including
+// its formatting, is kept as is.
+func Rewrite(src []byte, name, value string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	lit, err := findLiteral(file, name)
+	if err != nil {
+		return nil, err
+	}
+	start := fset.Position(lit.Pos()).Offset
+	end := fset.Position(lit.End()).Offset
+
+	out := make([]byte, 0, len(src)+len(value))
+	out = append(out, src[:start]...)
+	out = strconv.AppendQuote(out, value)
+	return append(out, src[end:]...), nil
+}
+
+// findLiteral finds the string literal assigned to a top-level const or var
+func findLiteral(file *ast.File, name string) (*ast.BasicLit, error) {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || (gen.Tok != token.CONST && gen.Tok != token.VAR) {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for i, ident := range vs.Names {
+				if ident.Name != name {
+					continue
+				}
+				if i >= len(vs.Values) {
+					return nil, fmt.Errorf("%s has no value to update", name)
+				}
+				lit, ok := vs.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					return nil, fmt.Errorf("%s is not assigned a string literal", name)
+				}
+				return lit, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no top-level const or var named %s", name)
+}
+
+// skeleton returns the source of a new version file
+func skeleton(pkg, name string) []byte {
+	return fmt.Appendf(nil,
+		"package %s\n\n// %s is the released version, updated by bumpkin on each release.\nconst %s = \"\"\n",
+		pkg, name, name,
+	)
+}
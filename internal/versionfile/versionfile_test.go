@@ -0,0 +1,96 @@
+package versionfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewrite_Const(t *testing.T) {
+	src := []byte(`package main
+
+// Version is set on release
+const Version = "1.0.0"
+
+func main() {}
+`)
+
+	out, err := Rewrite(src, "Version", "1.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, `package main
+
+// Version is set on release
+const Version = "1.1.0"
+
+func main() {}
+`, string(out))
+}
+
+func TestRewrite_VarBlock(t *testing.T) {
+	src := []byte(`package build
+
+var (
+	commit  = "abc"
+	version = "dev"
+)
+`)
+
+	out, err := Rewrite(src, "version", "2.0.0")
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `version = "2.0.0"`)
+	assert.Contains(t, string(out), `commit  = "abc"`)
+}
+
+func TestRewrite_KeepsFormatting(t *testing.T) {
+	// Not gofmt'ed, and the value is longer than before
+	src := []byte("package main\n\nvar (\n\tVersion   =   \"1.0.0\" // released\n\tx=1\n)\n")
+
+	out, err := Rewrite(src, "Version", "1.10.0-rc.1")
+	require.NoError(t, err)
+	assert.Equal(t,
+		"package main\n\nvar (\n\tVersion   =   \"1.10.0-rc.1\" // released\n\tx=1\n)\n",
+		string(out),
+	)
+}
+
+func TestRewrite_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"missing", "package x\n", "no top-level const or var named Version"},
+		{"no value", "package x\n\nvar Version string\n", "has no value"},
+		{"not a string", "package x\n\nconst Version = 1\n", "not assigned a string literal"},
+		{"syntax error", "package x\n\nconst Version =\n", "expected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Rewrite([]byte(tt.src), "Version", "1.0.0")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestUpdate_CreatesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "version.go")
+
+	changed, err := Update(path, "Version", "1.2.3", "main")
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "package main")
+	assert.Contains(t, string(data), `const Version = "1.2.3"`)
+
+	// Writing the same version again is a no-op
+	changed, err = Update(path, "Version", "1.2.3", "main")
+	require.NoError(t, err)
+	assert.False(t, changed)
+}